@@ -13,19 +13,66 @@ type Elements struct {
 	// Represents the strings prefixing the season in the file, e.g in "PART 2" "PART" is the AnimeSeasonPrefix.
 	AnimePartPrefix []string `json:"anime_part_prefix,omitempty"`
 
+	// Slice of strings representing the cour of a split season, e.g "2" in "2nd Cour" or "Cour II".
+	AnimeCour []string `json:"anime_cour,omitempty"`
+	// Represents the strings prefixing the cour in the file, e.g in "COUR 2" "COUR" is the AnimeCourPrefix.
+	AnimeCourPrefix []string `json:"anime_cour_prefix,omitempty"`
+
+	// Whether the title contains "Final Season", e.g "Shingeki no Kyojin The Final Season".
+	IsFinalSeason bool `json:"is_final_season,omitempty"`
+
 	// Title of the Anime. e.g in "[HorribleSubs] Boku no Hero Academia - 01 [1080p].mkv",
 	// "Boku No Hero Academia" is the AnimeTitle.
 	AnimeTitle string `json:"anime_title,omitempty"`
 
+	// Title of the Anime in its native script when the filename also includes a romanized title.
+	// e.g in "[LoliHouse] 葬送的芙莉莲 / Sousou no Frieren - 05 [1080p].mkv",
+	// "Sousou no Frieren" is the AnimeTitle and "葬送的芙莉莲" is the AnimeTitleNative.
+	AnimeTitleNative string `json:"anime_title_native,omitempty"`
+
+	// Other titles of the Anime found in the filename, in order of appearance, excluding the AnimeTitleNative.
+	// e.g in "Sousou no Frieren / Frieren - Beyond Journey's End - 05.mkv",
+	// "Sousou no Frieren" is the AnimeTitle and "Frieren - Beyond Journey's End" is an alternative title.
+	AnimeTitleAlternatives []string `json:"anime_title_alternatives,omitempty"`
+
 	// Slice of strings representing the types specified in the anime file, e.g ED, OP, Movie, etc.
 	AnimeType []string `json:"anime_type,omitempty"`
 
+	// Kind of video derived from AnimeType, Other and the numbers found in the filename,
+	// e.g "opening" for "NCOP", "movie" for "Title (2019)" and "special" for "S00E04".
+	MediaKind MediaKind `json:"media_kind,omitempty"`
+
+	// Bonus video details when MediaKind is an opening, ending, preview, CM or menu,
+	// e.g "NCED 2v2" is {Kind: "ending", Index: "2", Version: "2"}.
+	Extra *Extra `json:"extra,omitempty"`
+
+	// True if the episode is a special, i.e a numbered special or OVA, an episode of season 0 or a fractional episode,
+	// e.g "SP1", "OVA 2", "S00E04", "12.5".
+	IsSpecial bool `json:"is_special,omitempty"`
+
+	// Number of the special episode, e.g "02" in "SP 02". Also found in EpisodeNumber.
+	SpecialNumber string `json:"special_number,omitempty"`
+
+	// Kind of the special episode, either MediaKindSpecial or MediaKindOVA.
+	SpecialType MediaKind `json:"special_type,omitempty"`
+
 	// Year the anime was released.
 	AnimeYear string `json:"anime_year,omitempty"`
 
+	// Broadcast date of the episode, formatted as "2006-01-02".
+	// e.g in "[Raws] Title 2023-10-05 (MX 1440x1080).ts", "Title 20231005.ts" and "Title (23.10.05).ts", "2023-10-05" is the AirDate.
+	AirDate string `json:"air_date,omitempty"`
+
+	// TV station the video was recorded from, e.g "MX", "BS11", "AT-X", "TBS".
+	Broadcaster string `json:"broadcaster,omitempty"`
+
 	// Slice of strings representing the audio terms included in the filename, e.g FLAC, AAC, etc.
 	AudioTerm []string `json:"audio_term,omitempty"`
 
+	// Audio codecs and their channel layouts derived from AudioTerm.
+	// In "[2.0ch AAC]", "2.0ch" and "AAC" are parsed into []AudioTrack{{Codec: "AAC", Channels: "2.0"}}.
+	AudioTracks []AudioTrack `json:"audio_tracks,omitempty"`
+
 	// Slice of strings representing devices the video is compatible with that are mentioned in the filename.
 	DeviceCompatibility []string `json:"device_compatibility,omitempty"`
 
@@ -39,6 +86,11 @@ type Elements struct {
 	// 01 would be the EpisodeNumber, and 51 would be the EpisodeNumberAlt.
 	EpisodeNumberAlt []string `json:"episode_number_alt,omitempty"`
 
+	// Items of the episode list of batch and patch releases, keeping the range ends apart from the single episodes.
+	// e.g "Episodes 10~12,14" is []EpisodeRange{{From: "10", To: "12"}, {From: "14", To: "14"}}.
+	// Only set when the filename has a range or several episodes, which are all parsed into EpisodeNumber as well.
+	EpisodeRanges []EpisodeRange `json:"episode_ranges,omitempty"`
+
 	// Slice of strings representing the words prefixing the episode number in the file, e.g in "EPISODE 2", "EPISODE" is the prefix.
 	EpisodePrefix []string `json:"episode_prefix,omitempty"`
 
@@ -46,6 +98,11 @@ type Elements struct {
 	// "Pool Opening" is the EpisodeTitle.
 	EpisodeTitle string `json:"episode_title,omitempty"`
 
+	// Titles of each episode of multi-episode files, in the order of the episode numbers.
+	// e.g in "Title - 01-02 - Title A & Title B.mkv", "Title A & Title B" is the EpisodeTitle
+	// and []string{"Title A", "Title B"} are the EpisodeTitles.
+	EpisodeTitles []string `json:"episode_titles,omitempty"`
+
 	// Checksum of the file, in [BM&T] Toradora! - 07v2 - Pool Opening [720p Hi10 ] [BD] [8F59F2BA],
 	// "8F59F2BA" would be the FileChecksum.
 	FileChecksum string `json:"file_checksum,omitempty"`
@@ -60,6 +117,14 @@ type Elements struct {
 	// Languages specified in the file name, e.g RU, JP, EN etc.
 	Language []string `json:"language,omitempty"`
 
+	// BCP-47 codes of the audio languages derived from the language terms,
+	// e.g "JPN" is parsed into []string{"ja"} and "Dual-Audio" into []string{"ja", "en"}.
+	AudioLanguages []string `json:"audio_languages,omitempty"`
+
+	// BCP-47 codes of the subtitle languages derived from the language and subtitle terms,
+	// e.g "VOSTFR" and "Sub{Fr}" are parsed into []string{"fr"}, "CHS" into []string{"zh-Hans"}.
+	SubtitleLanguages []string `json:"subtitle_languages,omitempty"`
+
 	// Terms that could not be parsed into other buckets, but were deemed identifiers.
 	// In [chibi-Doki] Seikon no Qwaser - 13v0 (Uncensored Director's Cut) [988DB090].mkv,
 	// "Uncensored" is parsed into Other.
@@ -69,6 +134,13 @@ type Elements struct {
 	// "HorribleSubs" is the ReleaseGroup.
 	ReleaseGroup string `json:"release_group,omitempty"`
 
+	// Canonical names of the release groups, derived from ReleaseGroup.
+	// Collaborations are split, e.g "[GroupA & GroupB]" is []string{"GroupA", "GroupB"}.
+	// "x" only splits collaborations including a known group, e.g "[GroupA x SubsPlease]" is split
+	// but "[Spy x Family Fansubs]" is a single group.
+	// Aliases are resolved, e.g "[ERAI-RAWS]" is []string{"Erai-raws"}. See Options.ReleaseGroupAliases.
+	ReleaseGroups []string `json:"release_groups,omitempty"`
+
 	// Information about the release that wasn't a version.
 	// In "[SubDESU-H] Swing out Sisters Complete Version (720p x264 8bit AC3) [3ABD57E6].mp4
 	// "Complete" is parsed into ReleaseInformation.
@@ -78,9 +150,25 @@ type Elements struct {
 	// In [FBI] Baby Princess 3D Paradise Love 01v0 [BD][720p-AAC][457CC066].mkv, 0 is parsed into ReleaseVersion.
 	ReleaseVersion []string `json:"release_version,omitempty"`
 
+	// True if the episode is the last one of the series or season, e.g "Title - 12 END", "[FINAL]".
+	IsFinal bool `json:"is_final,omitempty"`
+
+	// True if the release replaces a previous one, e.g "REPACK", "PROPER", "(Fixed)", "[v2]".
+	IsRepack bool `json:"is_repack,omitempty"`
+
+	// Version of the release combining ReleaseVersion with the repack markers,
+	// e.g 2 for "05v2" and "05 REPACK", 3 for "05v2 REPACK". 0 if the filename has no version information.
+	EffectiveVersion int `json:"effective_version,omitempty"`
+
 	// Slice of strings representing where the video was ripped from. e.g BLU-RAY, DVD, etc.
 	Source []string `json:"source,omitempty"`
 
+	// Streaming platform the video was ripped from, e.g "CR", "AMZN", "NF".
+	StreamingService string `json:"streaming_service,omitempty"`
+
+	// Canonical kind of medium derived from Source, e.g "BD" for "Blu-Ray" or "BDRip".
+	SourceType SourceType `json:"source_type,omitempty"`
+
 	// Slice of strings representing the type of subtitles included, e.g HARDSUB, BIG5, etc.
 	Subtitles []string `json:"subtitles,omitempty"`
 
@@ -88,18 +176,48 @@ type Elements struct {
 	// on how it is represented in the filename.
 	VideoResolution string `json:"video_resolution,omitempty"`
 
+	// Structured resolution of the video, derived from VideoResolution or from
+	// resolution video terms like "4K". e.g "1920x1080" is parsed into {Width: 1920, Height: 1080, Label: "1080p"}.
+	Resolution *Resolution `json:"resolution,omitempty"`
+
 	// Slice of strings representing the video terms included in the filename, e.g h264, x264, etc.
 	VideoTerm []string `json:"video_term,omitempty"`
 
+	// Canonical video codec derived from VideoTerm, e.g "H.264", "h264" and "x264" are all "AVC".
+	VideoCodec VideoCodec `json:"video_codec,omitempty"`
+
+	// Bit depth of the video derived from VideoTerm, e.g 10 for "10bit" or "Hi10P".
+	VideoBitDepth int `json:"video_bit_depth,omitempty"`
+
+	// High dynamic range formats derived from VideoTerm, e.g "HDR", "DV".
+	VideoHDR []HDRFormat `json:"video_hdr,omitempty"`
+
 	// Slice of strings represnting the volume numbers. "01-10" would be represented as []string{"1", "10"}.
 	VolumeNumber []string `json:"volume_number,omitempty"`
 
 	// Slice of strings representing the words prefixing the volume number in the file, e.g in "VOLUME 2", "VOLUME" is the prefix.
 	VolumePrefix []string `json:"volume_prefix,omitempty"`
 
+	// Slice of strings representing the disc of DVD and BD rips, e.g in "Disc 2", "D1" and "CD1", 2, 1 and 1 are the DiscNumber.
+	DiscNumber []string `json:"disc_number,omitempty"`
+
+	// Slice of strings representing the words prefixing the disc number, e.g in "Disc 2", "Disc" is the prefix.
+	DiscPrefix []string `json:"disc_prefix,omitempty"`
+
+	// Slice of strings representing the part of a file split in several files, e.g "A" in "Part A", "1" in "pt1" and "a" in "07a".
+	// Unlike AnimePart, which is the part of a split cour, e.g "Part 2".
+	FilePart []string `json:"file_part,omitempty"`
+
+	// Slice of strings representing the words prefixing the file part, e.g in "Part A", "Part" is the prefix.
+	FilePartPrefix []string `json:"file_part_prefix,omitempty"`
+
 	// Entries that could not be parsed into any other categories.
 	Unknown []string `json:"unknown,omitempty"`
 
+	// Share of the characters of the filename, brackets and delimiters aside, assigned to some element, from 0 to 1.
+	// A low coverage is a sign of a misparse.
+	Coverage float64 `json:"coverage,omitempty"`
+
 	// Bool determining if "EpisodeNumberAlt" should be parsed or not.
 	checkAltNumber bool
 }
@@ -133,6 +251,17 @@ const (
 	elementCategoryUnknown
 	elementCategoryAnimePart
 	elementCategoryAnimePartPrefix
+	elementCategoryStreamingService
+	elementCategoryAnimeTitleNative
+	elementCategoryAnimeTitleAlternatives
+	elementCategoryAirDate
+	elementCategoryBroadcaster
+	elementCategoryDiscNumber
+	elementCategoryDiscPrefix
+	elementCategoryFilePart
+	elementCategoryFilePartPrefix
+	elementCategoryAnimeCour
+	elementCategoryAnimeCourPrefix
 )
 
 func (e *Elements) getCheckAltNumber() bool {
@@ -147,8 +276,14 @@ func (e *Elements) getSingleElementField(cat elementCategory) (bool, *string) {
 	switch cat {
 	case elementCategoryAnimeTitle:
 		return true, &e.AnimeTitle
+	case elementCategoryAnimeTitleNative:
+		return true, &e.AnimeTitleNative
 	case elementCategoryAnimeYear:
 		return true, &e.AnimeYear
+	case elementCategoryAirDate:
+		return true, &e.AirDate
+	case elementCategoryBroadcaster:
+		return true, &e.Broadcaster
 	case elementCategoryEpisodeTitle:
 		return true, &e.EpisodeTitle
 	case elementCategoryFileChecksum:
@@ -159,6 +294,8 @@ func (e *Elements) getSingleElementField(cat elementCategory) (bool, *string) {
 		return true, &e.FileName
 	case elementCategoryReleaseGroup:
 		return true, &e.ReleaseGroup
+	case elementCategoryStreamingService:
+		return true, &e.StreamingService
 	case elementCategoryVideoResolution:
 		return true, &e.VideoResolution
 	}
@@ -168,6 +305,10 @@ func (e *Elements) getSingleElementField(cat elementCategory) (bool, *string) {
 
 func (e *Elements) getMultiElementField(cat elementCategory) (bool, *[]string) {
 	switch cat {
+	case elementCategoryAnimeCour:
+		return true, &e.AnimeCour
+	case elementCategoryAnimeCourPrefix:
+		return true, &e.AnimeCourPrefix
 	case elementCategoryAnimePart:
 		return true, &e.AnimePart
 	case elementCategoryAnimePartPrefix:
@@ -176,18 +317,28 @@ func (e *Elements) getMultiElementField(cat elementCategory) (bool, *[]string) {
 		return true, &e.AnimeSeason
 	case elementCategoryAnimeSeasonPrefix:
 		return true, &e.AnimeSeasonPrefix
+	case elementCategoryAnimeTitleAlternatives:
+		return true, &e.AnimeTitleAlternatives
 	case elementCategoryAnimeType:
 		return true, &e.AnimeType
 	case elementCategoryAudioTerm:
 		return true, &e.AudioTerm
 	case elementCategoryDeviceCompatibility:
 		return true, &e.DeviceCompatibility
+	case elementCategoryDiscNumber:
+		return true, &e.DiscNumber
+	case elementCategoryDiscPrefix:
+		return true, &e.DiscPrefix
 	case elementCategoryEpisodeNumber:
 		return true, &e.EpisodeNumber
 	case elementCategoryEpisodeNumberAlt:
 		return true, &e.EpisodeNumberAlt
 	case elementCategoryEpisodePrefix:
 		return true, &e.EpisodePrefix
+	case elementCategoryFilePart:
+		return true, &e.FilePart
+	case elementCategoryFilePartPrefix:
+		return true, &e.FilePartPrefix
 	case elementCategoryLanguage:
 		return true, &e.Language
 	case elementCategoryOther:
@@ -281,17 +432,22 @@ func (e elementCategory) isSearchable() bool {
 	searchableCategories := []elementCategory{
 		elementCategoryAnimeSeasonPrefix,
 		elementCategoryAnimePartPrefix,
+		elementCategoryAnimeCourPrefix,
 		elementCategoryAnimeType,
 		elementCategoryAudioTerm,
+		elementCategoryBroadcaster,
 		elementCategoryDeviceCompatibility,
+		elementCategoryDiscPrefix,
 		elementCategoryEpisodePrefix,
 		elementCategoryFileChecksum,
+		elementCategoryFilePartPrefix,
 		elementCategoryLanguage,
 		elementCategoryOther,
 		elementCategoryReleaseGroup,
 		elementCategoryReleaseInformation,
 		elementCategoryReleaseVersion,
 		elementCategorySource,
+		elementCategoryStreamingService,
 		elementCategorySubtitles,
 		elementCategoryVideoResolution,
 		elementCategoryVideoTerm,
@@ -308,16 +464,21 @@ func (e elementCategory) isSearchable() bool {
 
 func (e elementCategory) isSingular() bool {
 	nonSingularCategories := []elementCategory{
+		elementCategoryAnimeCour,
 		elementCategoryAnimePart,
 		elementCategoryAnimeSeason,
+		elementCategoryAnimeTitleAlternatives,
 		elementCategoryAnimeType,
 		elementCategoryAudioTerm,
 		elementCategoryDeviceCompatibility,
+		elementCategoryDiscNumber,
 		elementCategoryEpisodeNumber,
+		elementCategoryFilePart,
 		elementCategoryLanguage,
 		elementCategoryOther,
 		elementCategoryReleaseInformation,
 		elementCategorySource,
+		elementCategorySubtitles,
 		elementCategoryVideoTerm,
 	}
 
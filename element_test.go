@@ -5,14 +5,21 @@ import (
 )
 
 var multiElementFields = []elementCategory{
+	elementCategoryAnimeCour,
+	elementCategoryAnimeCourPrefix,
 	elementCategoryAnimeSeason,
 	elementCategoryAnimeSeasonPrefix,
+	elementCategoryAnimeTitleAlternatives,
 	elementCategoryAnimeType,
 	elementCategoryAudioTerm,
 	elementCategoryDeviceCompatibility,
+	elementCategoryDiscNumber,
+	elementCategoryDiscPrefix,
 	elementCategoryEpisodeNumber,
 	elementCategoryEpisodeNumberAlt,
 	elementCategoryEpisodePrefix,
+	elementCategoryFilePart,
+	elementCategoryFilePartPrefix,
 	elementCategoryLanguage,
 	elementCategoryOther,
 	elementCategoryReleaseInformation,
@@ -26,13 +33,17 @@ var multiElementFields = []elementCategory{
 }
 
 var singleElementFields = []elementCategory{
+	elementCategoryAirDate,
 	elementCategoryAnimeTitle,
+	elementCategoryAnimeTitleNative,
 	elementCategoryAnimeYear,
+	elementCategoryBroadcaster,
 	elementCategoryEpisodeTitle,
 	elementCategoryFileChecksum,
 	elementCategoryFileExtension,
 	elementCategoryFileName,
 	elementCategoryReleaseGroup,
+	elementCategoryStreamingService,
 	elementCategoryVideoResolution,
 }
 
@@ -148,6 +159,7 @@ func TestElementIsSingular(t *testing.T) {
 		elementCategoryOther,
 		elementCategoryReleaseInformation,
 		elementCategorySource,
+		elementCategorySubtitles,
 		elementCategoryVideoTerm,
 	}
 
@@ -0,0 +1,158 @@
+package tanuki
+
+import (
+	"regexp"
+	"strings"
+)
+
+// EpisodeRange is an item of an episode list, e.g "10~12" is {From: "10", To: "12"}.
+// Single episodes start and end with the same number, e.g "14" is {From: "14", To: "14"}.
+type EpisodeRange struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+// e.g "01", "1-3", "10~12", "01v2"
+var episodeListItemRe = regexp.MustCompile(`^(\d{1,4})(?:[vV](\d))?(?:[-~\x{301C}](\d{1,4})(?:[vV](\d))?)?$`)
+
+// Characters separating the items of an episode list, e.g "01, 03", "01+02", "1-3 & 7"
+const episodeListSeparators = ",&+"
+
+type episodeListItem struct {
+	EpisodeRange
+	versions []string
+}
+
+// Parses the items of a token, e.g "01+02" is {01 01}, {02 02}
+func parseEpisodeListItems(content string) ([]episodeListItem, bool) {
+	var items []episodeListItem
+	for _, part := range strings.FieldsFunc(content, func(r rune) bool {
+		return strings.ContainsRune(episodeListSeparators, r)
+	}) {
+		match := episodeListItemRe.FindStringSubmatch(part)
+		if match == nil {
+			return nil, false
+		}
+		item := episodeListItem{EpisodeRange: EpisodeRange{From: match[1], To: match[1]}}
+		if match[3] != "" {
+			item.To = match[3]
+		}
+		for _, v := range []string{match[2], match[4]} {
+			if v != "" {
+				item.versions = append(item.versions, v)
+			}
+		}
+		items = append(items, item)
+	}
+	return items, len(items) > 0
+}
+
+// Returns true if the tokens between two items of an episode list are separators, e.g ", ", " & ", "+"
+func isEpisodeListSeparator(tkns tokens) bool {
+	separated := false
+	for _, tkn := range tkns {
+		if tkn.Category == tokenCategoryBracket || tkn.Category == tokenCategoryInvalid {
+			return false
+		}
+		content := strings.TrimSpace(tkn.Content)
+		if content == "" {
+			continue
+		}
+		if len(content) != 1 || !strings.Contains(episodeListSeparators, content) {
+			return false
+		}
+		separated = true
+	}
+	return separated
+}
+
+// Extend the episode number into the list it belongs to, e.g "01, 03, 05", "01+02+05", "Ep 1-3 & 7", "Episodes 10~12,14".
+// All the numbers of the list are parsed into EpisodeNumber, and the items into EpisodeRanges.
+func (p *parser) searchForEpisodeList() {
+	elems := p.tokenizer.elements
+	if !elems.contains(elementCategoryEpisodeNumber) {
+		return
+	}
+	episodeNumber := elems.EpisodeNumber[0]
+
+	all := *p.tokenizer.tokens
+	for i := 0; i < len(all); i++ {
+		items, ok := parseEpisodeListItems(all[i].Content)
+		if !ok || (all[i].Category != tokenCategoryUnknown && all[i].Category != tokenCategoryIdentifier) {
+			continue
+		}
+
+		// Build the chain of items separated by list separators
+		chain := tokens{all[i]}
+		var separators tokens
+		end := i
+		for j := i + 1; j < len(all); j++ {
+			if all[j].Category != tokenCategoryUnknown && all[j].Category != tokenCategoryIdentifier {
+				if all[j].Category != tokenCategoryDelimiter {
+					break
+				}
+				continue
+			}
+			next, ok := parseEpisodeListItems(all[j].Content)
+			if !ok {
+				if isEpisodeListSeparator(tokens{all[j]}) {
+					continue
+				}
+				break
+			}
+			if !isEpisodeListSeparator(all[end+1 : j]) {
+				break
+			}
+			separators = append(separators, all[end+1:j]...)
+			chain = append(chain, all[j])
+			items = append(items, next...)
+			end = j
+		}
+
+		if !containsEpisodeNumber(items, episodeNumber) || (len(items) < 2 && items[0].From == items[0].To) {
+			i = end
+			continue
+		}
+		if !p.isValidEpisodeList(items) {
+			return
+		}
+
+		elems.erase(elementCategoryEpisodeNumber)
+		for _, item := range items {
+			elems.insert(elementCategoryEpisodeNumber, item.From)
+			elems.insert(elementCategoryEpisodeNumber, item.To)
+			for _, v := range item.versions {
+				elems.insert(elementCategoryReleaseVersion, v)
+			}
+			elems.EpisodeRanges = append(elems.EpisodeRanges, item.EpisodeRange)
+		}
+		for _, tkn := range append(chain, separators...) {
+			if tkn.Category == tokenCategoryUnknown {
+				tkn.Category = tokenCategoryIdentifier
+			}
+		}
+		return
+	}
+}
+
+func containsEpisodeNumber(items []episodeListItem, number string) bool {
+	for _, item := range items {
+		if item.From == number || item.To == number {
+			return true
+		}
+	}
+	return false
+}
+
+// Returns true if the items are in ascending order and within the limits, e.g "10~12,14" but not "12~10" or "05, 03"
+func (p *parser) isValidEpisodeList(items []episodeListItem) bool {
+	previous := -1
+	for _, item := range items {
+		from, to := stringToInt(item.From), stringToInt(item.To)
+		if from <= previous || to < from || !p.isValidEpisodeNumber(item.To) {
+			return false
+		}
+		previous = to
+	}
+	return true
+}
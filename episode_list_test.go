@@ -0,0 +1,46 @@
+package tanuki
+
+import "testing"
+
+func TestEpisodeListParseEpisodeListItems(t *testing.T) {
+	items, ret := parseEpisodeListItems("01+02")
+	if !ret {
+		t.Error("expected true, got false")
+	}
+	if len(items) != 2 || items[1].From != "02" || items[1].To != "02" {
+		t.Errorf("expected [{01 01} {02 02}], got %v", items)
+	}
+	items, _ = parseEpisodeListItems("10~12")
+	if len(items) != 1 || items[0].From != "10" || items[0].To != "12" {
+		t.Errorf("expected [{10 12}], got %v", items)
+	}
+	_, ret = parseEpisodeListItems("1080p")
+	if ret {
+		t.Error("expected false, got true")
+	}
+}
+
+func TestEpisodeListSearchForEpisodeList(t *testing.T) {
+	e := Parse("[Group] Title Ep 1-3 & 7 [1080p].mkv", DefaultOptions)
+	if len(e.EpisodeRanges) != 2 || e.EpisodeRanges[0] != (EpisodeRange{"1", "3"}) || e.EpisodeRanges[1] != (EpisodeRange{"7", "7"}) {
+		t.Errorf("expected [{1 3} {7 7}], got %v", e.EpisodeRanges)
+	}
+	if len(e.EpisodeNumber) != 3 {
+		t.Errorf("expected [1 3 7], got %v", e.EpisodeNumber)
+	}
+	if e.EpisodeTitle != "" {
+		t.Errorf("expected \"\", got \"%s\"", e.EpisodeTitle)
+	}
+	e = Parse("[Group] Title - 01, 03, 05 [1080p].mkv", DefaultOptions)
+	if len(e.EpisodeRanges) != 3 {
+		t.Errorf("expected 3 ranges, got %v", e.EpisodeRanges)
+	}
+	e = Parse("[Group] Title - 05 [1080p].mkv", DefaultOptions)
+	if e.EpisodeRanges != nil {
+		t.Errorf("expected nil, got %v", e.EpisodeRanges)
+	}
+	e = Parse("[Group] Title - 05, 03 [1080p].mkv", DefaultOptions)
+	if e.EpisodeRanges != nil {
+		t.Errorf("expected nil, got %v", e.EpisodeRanges)
+	}
+}
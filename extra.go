@@ -0,0 +1,123 @@
+package tanuki
+
+import (
+	"regexp"
+)
+
+// Extra is a bonus video of a release, e.g a creditless opening, a promotional video or a BD menu.
+type Extra struct {
+	// Kind of the extra, one of MediaKindOpening, MediaKindEnding, MediaKindPreview, MediaKindCommercial and MediaKindMenu.
+	Kind MediaKind `json:"kind,omitempty"`
+
+	// Index of the extra among those of the same kind, e.g "1" in "NCOP1" and "4a" in "OP4a".
+	Index string `json:"index,omitempty"`
+
+	// Version of the extra, e.g "2" in "NCED 2v2".
+	Version string `json:"version,omitempty"`
+
+	// Episode the extra is attached to, e.g "12" in "Creditless ED (Ep. 12)". It is not kept in EpisodeNumber.
+	Episode string `json:"episode,omitempty"`
+}
+
+// e.g "1", "01", "4a", "2v2"
+var extraNumberRe = regexp.MustCompile(`(?i)^(\d{1,3}[a-e]?)(?:v(\d))?$`)
+
+// e.g "Creditless" in "Creditless ED"
+func isExtraModifierWord(w string) bool {
+	switch w {
+	case "CREDITLESS", "TEXTLESS", "NC":
+		return true
+	}
+	return false
+}
+
+func isExtraKind(kind MediaKind) bool {
+	switch kind {
+	case MediaKindOpening, MediaKindEnding, MediaKindPreview, MediaKindCommercial, MediaKindMenu:
+		return true
+	}
+	return false
+}
+
+// Returns the kind of extra of a keyword, e.g MediaKindOpening for "NCOP"
+func (p *parser) extraKind(w string) (MediaKind, bool) {
+	kind, found := mediaKinds[p.tokenizer.keywordManager.normalize(w)]
+	if !found || !isExtraKind(kind) {
+		return "", false
+	}
+	return kind, true
+}
+
+// Set the index and version of the extra, e.g "2v2" in "NCED 2v2"
+func (p *parser) setExtraNumber(number string) bool {
+	match := extraNumberRe.FindStringSubmatch(number)
+	if match == nil || isResolution(number) {
+		return false
+	}
+	elems := p.tokenizer.elements
+	if elems.Extra == nil {
+		elems.Extra = &Extra{}
+	}
+	elems.Extra.Index = match[1]
+	if match[2] != "" {
+		elems.Extra.Version = match[2]
+		elems.insert(elementCategoryReleaseVersion, match[2])
+	}
+	return true
+}
+
+// Identify the extra keyword along with the number following it and the modifier preceding it,
+// e.g "Menu 2", "Creditless ED (Ep. 12)".
+// The keyword is only identified when it is followed by its number, a bracket or nothing,
+// so that titles including these words are kept intact.
+func (p *parser) checkExtraKeyword(tkn *token) bool {
+	if _, found := p.extraKind(tkn.Content); !found {
+		return false
+	}
+	prevToken, prevFound := p.tokenizer.tokens.findPrevious(*tkn, tokenFlagsNotDelimiter)
+	modifier := prevFound && prevToken.Category == tokenCategoryUnknown &&
+		isExtraModifierWord(p.tokenizer.keywordManager.normalize(prevToken.Content))
+
+	nextToken, found := p.tokenizer.tokens.findNext(*tkn, tokenFlagsNotDelimiter)
+	if found && nextToken.Category == tokenCategoryUnknown && p.setExtraNumber(nextToken.Content) {
+		nextToken.Category = tokenCategoryIdentifier
+	} else if found && nextToken.Category != tokenCategoryBracket {
+		return false
+	} else if prevFound && prevToken.Category == tokenCategoryUnknown && !modifier && !isDashCharacter(prevToken.Content) {
+		return false // e.g "Pool Opening"
+	}
+	tkn.Category = tokenCategoryIdentifier
+	if modifier {
+		prevToken.Category = tokenCategoryIdentifier
+	}
+	return true
+}
+
+// Build the extra from the extra keywords found outside the titles, moving the episode number into it if any,
+// e.g "Summer Pool Opening - 03" is not an extra.
+func (p *parser) resolveExtra() {
+	elems := p.tokenizer.elements
+
+	var kind MediaKind
+	found := p.identifiedMediaKinds()
+	for _, k := range mediaKindPrecedence {
+		if found[k] && isExtraKind(k) {
+			kind = k
+			break
+		}
+	}
+	if kind == "" {
+		elems.Extra = nil
+		return
+	}
+	if elems.Extra == nil {
+		elems.Extra = &Extra{}
+	}
+	elems.Extra.Kind = kind
+	// The episode number only tells which episode the extra belongs to, so it is moved into the extra
+	if len(elems.EpisodeNumber) > 0 {
+		elems.Extra.Episode = elems.EpisodeNumber[0]
+		elems.erase(elementCategoryEpisodeNumber)
+		elems.erase(elementCategoryEpisodePrefix)
+	}
+}
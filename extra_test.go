@@ -0,0 +1,53 @@
+package tanuki
+
+import "testing"
+
+func TestExtraSetExtraNumber(t *testing.T) {
+	psr := getTestParser("")
+	ret := psr.setExtraNumber("2v2")
+	if !ret {
+		t.Error("expected true, got false")
+	}
+	if psr.tokenizer.elements.Extra.Index != "2" || psr.tokenizer.elements.Extra.Version != "2" {
+		t.Errorf("expected {2 2}, got %v", *psr.tokenizer.elements.Extra)
+	}
+	ret = psr.setExtraNumber("720p")
+	if ret {
+		t.Error("expected false, got true")
+	}
+}
+
+func TestExtraCheckExtraKeyword(t *testing.T) {
+	e := Parse("[Group] Title - NCOP1 [1080p].mkv", DefaultOptions)
+	if e.Extra == nil || e.Extra.Kind != MediaKindOpening || e.Extra.Index != "1" {
+		t.Errorf("expected {opening 1}, got %v", e.Extra)
+	}
+	if len(e.EpisodeNumber) != 0 {
+		t.Errorf("expected [], got %v", e.EpisodeNumber)
+	}
+	e = Parse("[Group] Title - Creditless ED (Ep. 12) [1080p].mkv", DefaultOptions)
+	if e.Extra == nil || e.Extra.Kind != MediaKindEnding || e.Extra.Episode != "12" {
+		t.Errorf("expected {ending 12}, got %v", e.Extra)
+	}
+	if len(e.EpisodeNumber) != 0 {
+		t.Errorf("expected [], got %v", e.EpisodeNumber)
+	}
+	if len(e.EpisodePrefix) != 0 {
+		t.Errorf("expected [], got %v", e.EpisodePrefix)
+	}
+	if e.AnimeTitle != "Title" {
+		t.Errorf("expected \"Title\", got \"%s\"", e.AnimeTitle)
+	}
+	e = Parse("[BM&T] Toradora! - 07v2 - Pool Opening [720p Hi10 ] [BD] [8F59F2BA]", DefaultOptions)
+	if e.Extra != nil {
+		t.Errorf("expected nil, got %v", e.Extra)
+	}
+	e = Parse("[Group] Summer Pool Opening - 03.mkv", DefaultOptions)
+	if e.Extra != nil {
+		t.Errorf("expected nil, got %v", e.Extra)
+	}
+	e = Parse("[Group] The Menu - 05.mkv", DefaultOptions)
+	if e.Extra != nil {
+		t.Errorf("expected nil, got %v", e.Extra)
+	}
+}
@@ -0,0 +1,78 @@
+package tanuki
+
+import (
+	"regexp"
+	"strings"
+)
+
+// e.g "D1", "CD2", "DISC3"
+var discNumberRe = regexp.MustCompile(`(?i)^(D|CD|DISC|DISK)(\d{1,2})$`)
+
+// e.g "PT1", "PT.2"
+var filePartNumberRe = regexp.MustCompile(`(?i)^PT\.?(\d{1,2})$`)
+
+// e.g "A" in "Part A"
+var filePartLetterRe = regexp.MustCompile(`(?i)^[A-D]$`)
+
+func (p *parser) setDiscNumber(prefix, number string, tkns ...*token) {
+	p.tokenizer.elements.insert(elementCategoryDiscPrefix, prefix)
+	p.tokenizer.elements.insert(elementCategoryDiscNumber, number)
+	for _, tkn := range tkns {
+		tkn.Category = tokenCategoryIdentifier
+	}
+}
+
+func (p *parser) setFilePart(prefix, part string, tkns ...*token) {
+	if prefix != "" {
+		p.tokenizer.elements.insert(elementCategoryFilePartPrefix, prefix)
+	}
+	p.tokenizer.elements.insert(elementCategoryFilePart, part)
+	for _, tkn := range tkns {
+		tkn.Category = tokenCategoryIdentifier
+	}
+}
+
+// Identify the disc prefix along with the number following it, e.g "Disc 2", "CD 1".
+func (p *parser) checkDiscKeyword(tkn *token) bool {
+	nextToken, found := p.tokenizer.tokens.findNext(*tkn, tokenFlagsNotDelimiter)
+	if !found || nextToken.Category != tokenCategoryUnknown || !isNumeric(nextToken.Content) || len(nextToken.Content) > 2 {
+		return false
+	}
+	p.setDiscNumber(tkn.Content, nextToken.Content, tkn, nextToken)
+	return true
+}
+
+// Identify the file part prefix along with the part following it, e.g "PT 1", "Part A".
+// Numbered parts are only file parts after "PT", since "Part 2" is the part of a split cour.
+func (p *parser) checkFilePartKeyword(tkn *token) bool {
+	nextToken, found := p.tokenizer.tokens.findNext(*tkn, tokenFlagsNotDelimiter)
+	if !found || nextToken.Category != tokenCategoryUnknown {
+		return false
+	}
+	if filePartLetterRe.MatchString(nextToken.Content) ||
+		(p.tokenizer.keywordManager.normalize(tkn.Content) == "PT" && isNumeric(nextToken.Content) && len(nextToken.Content) <= 2) {
+		p.setFilePart(tkn.Content, nextToken.Content, tkn, nextToken)
+		return true
+	}
+	return false
+}
+
+// Match the disc and file part numbers joined with their prefix, e.g "CD1", "Disc2", "pt1".
+// "D1" is only a disc when it doesn't precede a title word, e.g "[D1]" or "Title D1 [1080p]".
+func (p *parser) matchFilePartPattern(w string, tkn *token) bool {
+	if match := discNumberRe.FindStringSubmatch(w); match != nil {
+		if strings.ToUpper(match[1]) == "D" {
+			nextToken, found := p.tokenizer.tokens.findNext(*tkn, tokenFlagsNotDelimiter)
+			if found && nextToken.Category == tokenCategoryUnknown {
+				return false
+			}
+		}
+		p.setDiscNumber(match[1], match[2], tkn)
+		return true
+	}
+	if match := filePartNumberRe.FindStringSubmatch(w); match != nil {
+		p.setFilePart(w[:len(w)-len(match[1])], match[1], tkn)
+		return true
+	}
+	return false
+}
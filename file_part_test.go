@@ -0,0 +1,65 @@
+package tanuki
+
+import "testing"
+
+func TestFilePartCheckDiscKeyword(t *testing.T) {
+	e := Parse("[Group] Title - Disc 2 [BD 1080p].mkv", DefaultOptions)
+	if len(e.DiscNumber) != 1 || e.DiscNumber[0] != "2" {
+		t.Errorf("expected [2], got %v", e.DiscNumber)
+	}
+	if e.AnimeTitle != "Title" {
+		t.Errorf("expected \"Title\", got \"%s\"", e.AnimeTitle)
+	}
+	e = Parse("Disc Wars Avengers - 01.mkv", DefaultOptions)
+	if len(e.DiscNumber) != 0 {
+		t.Errorf("expected [], got %v", e.DiscNumber)
+	}
+	if e.AnimeTitle != "Disc Wars Avengers" {
+		t.Errorf("expected \"Disc Wars Avengers\", got \"%s\"", e.AnimeTitle)
+	}
+}
+
+func TestFilePartCheckFilePartKeyword(t *testing.T) {
+	e := Parse("[Group] Title Movie Part A [1080p].mkv", DefaultOptions)
+	if len(e.FilePart) != 1 || e.FilePart[0] != "A" {
+		t.Errorf("expected [A], got %v", e.FilePart)
+	}
+	if len(e.AnimePart) != 0 {
+		t.Errorf("expected [], got %v", e.AnimePart)
+	}
+	e = Parse("Title Season 2 Part 2 - 03.mkv", DefaultOptions)
+	if len(e.FilePart) != 0 {
+		t.Errorf("expected [], got %v", e.FilePart)
+	}
+	if len(e.AnimePart) != 1 || e.AnimePart[0] != "2" {
+		t.Errorf("expected [2], got %v", e.AnimePart)
+	}
+}
+
+func TestFilePartMatchFilePartPattern(t *testing.T) {
+	e := Parse("Title CD1.avi", DefaultOptions)
+	if len(e.DiscNumber) != 1 || e.DiscNumber[0] != "1" {
+		t.Errorf("expected [1], got %v", e.DiscNumber)
+	}
+	e = Parse("[Group] Title D1 [DVD].mkv", DefaultOptions)
+	if len(e.DiscNumber) != 1 || e.DiscNumber[0] != "1" {
+		t.Errorf("expected [1], got %v", e.DiscNumber)
+	}
+	e = Parse("[Group] Title - 05 pt1 [720p].mkv", DefaultOptions)
+	if len(e.FilePart) != 1 || e.FilePart[0] != "1" {
+		t.Errorf("expected [1], got %v", e.FilePart)
+	}
+	if len(e.EpisodeNumber) != 1 || e.EpisodeNumber[0] != "05" {
+		t.Errorf("expected [05], got %v", e.EpisodeNumber)
+	}
+}
+
+func TestFilePartMatchPartialEpisodePattern(t *testing.T) {
+	e := Parse("[Group] Title - 07a [720p].mkv", DefaultOptions)
+	if len(e.EpisodeNumber) != 1 || e.EpisodeNumber[0] != "07" {
+		t.Errorf("expected [07], got %v", e.EpisodeNumber)
+	}
+	if len(e.FilePart) != 1 || e.FilePart[0] != "a" {
+		t.Errorf("expected [a], got %v", e.FilePart)
+	}
+}
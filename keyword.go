@@ -1,6 +1,7 @@
 package tanuki
 
 import (
+	"regexp"
 	"sort"
 	"strings"
 
@@ -10,6 +11,9 @@ import (
 type indexSet struct {
 	beginPos int
 	endPos   int
+
+	// Set if the text is identified during the scan, otherwise it is left to the keyword search
+	category elementCategory
 }
 
 type indexSets []indexSet
@@ -28,6 +32,9 @@ type keyword struct {
 type keywordManager struct {
 	keywords       map[string]keyword
 	fileExtensions map[string]keyword
+
+	// Technical keywords looked for by scan, sorted from the longest to the shortest
+	scannable []string
 }
 
 var (
@@ -56,6 +63,12 @@ var (
 		searchable:   false,
 		valid:        true,
 	}
+	// Keywords that collide with title words and are only identified from their context
+	keywordOptionsAmbiguous = keywordOption{
+		identifiable: true,
+		searchable:   false,
+		valid:        true,
+	}
 )
 
 func newKeywordManager() *keywordManager {
@@ -64,11 +77,15 @@ func newKeywordManager() *keywordManager {
 		fileExtensions: make(map[string]keyword),
 	}
 
-	kwm.add(elementCategoryAnimeSeasonPrefix, keywordOptionsUnidentifiable, []string{"S", "SAISON", "SEASON", "SEASONS", "SAISONS"})
+	kwm.add(elementCategoryAnimeSeasonPrefix, keywordOptionsUnidentifiable, []string{
+		"S", "SAISON", "SEASON", "SEASONS", "SAISONS",
+		"TEMPORADA", "TEMPORADAS", "STAFFEL", "STAFFELN", "STAGIONE", "STAGIONI",
+		"СЕЗОН", "СЕЗОНЫ", "시즌"})
 	kwm.add(elementCategoryAnimePartPrefix, keywordOptionsUnidentifiable, []string{"PARTS", "PART"})
+	kwm.add(elementCategoryAnimeCourPrefix, keywordOptionsUnidentifiable, []string{"COUR", "COURS", "クール"})
 	kwm.add(elementCategoryAnimeType, keywordOptionsUnidentifiable, []string{
 		"GEKIJOUBAN", "MOVIE", "OAD", "OAV", "ONA", "OVA", "SPECIAL", "SPECIALS",
-		"TV", "番外編", "總集編", "映像特典", "特典", "特典アニメ"})
+		"TV", "RECAP", "番外編", "總集編", "映像特典", "特典", "特典アニメ"})
 	kwm.add(elementCategoryAnimeType, keywordOptionsUnidentifiableUnsearchable, []string{
 		"SP"}) // e.g "Yumeiro Patissiere SP Professional"
 	kwm.add(elementCategoryAnimeType, keywordOptionsUnidentifiableInvalid, []string{
@@ -77,19 +94,30 @@ func newKeywordManager() *keywordManager {
 	kwm.add(elementCategoryAudioTerm, keywordOptionsDefault, []string{
 		// Audio channels
 		"2.0CH", "2CH", "5.1", "5.1CH", "DTS", "DTS-ES", "DTS5.1", "TRUEHD5.1",
+		"AAC2.0", "AAC5.1", "DD5.1", "DDP2.0", "DDP5.1", "FLAC2.0",
 		// Audio codec
-		"AAC", "AACX2", "AACX3", "AACX4", "AC3", "EAC3", "E-AC-3", "FLAC",
-		"FLACX2", "FLACX3", "FLACX4", "LOSSLESS", "MP3", "OGG", "VORBIS",
-		"DD2", "DD2.0",
+		"AAC", "AACX2", "AACX3", "AACX4", "AC3", "EAC3", "E-AC-3", "E-AC3", "FLAC",
+		"FLACX2", "FLACX3", "FLACX4", "LOSSLESS", "MP3", "OGG", "VORBIS", "OPUS",
+		"DD2", "DD2.0", "DDP", "TRUEHD", "DTS-HD", "DTS-HDMA", "LPCM", "PCM",
 		// Audio language
-		"DUALAUDIO", "DUAL-AUDIO"})
+		"DUALAUDIO", "DUAL-AUDIO", "DUAL AUDIO", "MULTI-AUDIO"})
+	kwm.add(elementCategoryBroadcaster, keywordOptionsAmbiguous, []string{
+		"AT-X", "ATX", "BS11", "BS-11", "BS4", "BS-TBS", "BS-NTV", "BS-FUJI", "BSJ",
+		"MBS", "NHK", "NHKE", "TBS", "TOKYOMX", "TOKYO-MX", "WOWOW",
+		"CX", "EX", "MX", "NTV", "TX", "YTV"}) // e.g "MX" in "(MX 1440x1080)", but not "Welcome to the NHK"
 	kwm.add(elementCategoryDeviceCompatibility, keywordOptionsDefault, []string{
 		"IPAD3", "IPHONE5", "IPOD", "PS3", "XBOX", "XBOX360"})
 	kwm.add(elementCategoryDeviceCompatibility, keywordOptionsUnidentifiable, []string{
 		"ANDROID"})
+	kwm.add(elementCategoryDiscPrefix, keywordOptionsDefault, []string{
+		"DISC", "DISCS", "DISK", "DISKS"})
+	kwm.add(elementCategoryDiscPrefix, keywordOptionsUnidentifiable, []string{
+		"CD"})
 	kwm.add(elementCategoryEpisodePrefix, keywordOptionsDefault, []string{
 		"EP", "EP.", "EPS", "EPS.", "EPISODE", "EPISODE.", "EPISODES",
-		"CAPITULO", "EPISODIO", "EPIS\u00F3DIO", "FOLGE"})
+		"CAPITULO", "CAPITULOS", "EPISODIO", "EPISODIOS", "EPISODI", "FOLGE", "FOLGEN",
+		"СЕРИЯ", "СЕРИИ", "ЭПИЗОД", "ЭПИЗОДЫ",
+		"TAP", "ตอน", "ตอนที่"}) // "Tập" once normalized
 	kwm.add(elementCategoryEpisodePrefix, keywordOptionsInvalid, []string{
 		"E", "\x7B2C"}) // Single letter episode keywords are not valid tokens
 	kwm.add(elementCategoryFileExtension, keywordOptionsDefault, []string{
@@ -98,47 +126,71 @@ func newKeywordManager() *keywordManager {
 	kwm.add(elementCategoryFileExtension, keywordOptionsInvalid, []string{
 		"AAC", "AIFF", "FLAC", "M4A", "MP3", "MKA", "OGG", "WAV", "WMA",
 		"7Z", "RAR", "ZIP", "ASS", "SRT"})
+	kwm.add(elementCategoryFilePartPrefix, keywordOptionsUnidentifiable, []string{
+		"PT"})
 	kwm.add(elementCategoryLanguage, keywordOptionsDefault, []string{
-		"ENG", "ENGLISH", "ESPANOL", "JAP", "PT-BR", "SPANISH", "VOSTFR"})
+		"ENG", "ENGLISH", "ESPANOL", "JAP", "JPN", "PT-BR", "POR-BR",
+		"SPANISH", "VOSTFR", "VOST",
+		"ОЗВУЧКА", "ДУБЛЯЖ", "СУБТИТРЫ", "자막", "한글자막"})
+	kwm.add(elementCategoryLanguage, keywordOptionsAmbiguous, []string{
+		"JAPANESE", "CASTELLANO", "LATINO", "PORTUGUESE", "FRENCH", "GERMAN",
+		"DEUTSCH", "ITALIAN", "RUSSIAN", "CHINESE", "KOREAN", "ARABIC",
+		"INDONESIAN", "VIETNAMESE"}) // e.g "The Italian Job", "Chinese Paladin"
 	kwm.add(elementCategoryLanguage, keywordOptionsUnidentifiable, []string{
-		"ESP", "ITA"}) // e.g "Tokyo ESP", "Bokura ga Ita"
+		"ESP", "ITA", // e.g "Tokyo ESP", "Bokura ga Ita"
+		"SPA", "LAT", "POR", "FRE", "FRA", "GER", "DEU", "RUS", "CHI", "KOR",
+		"ARA", "VF", "VFF", "THAI"})
 	kwm.add(elementCategoryOther, keywordOptionsDefault, []string{
 		"REMASTER", "REMASTERED", "UNCENSORED", "UNCUT", "TS", "VFR",
 		"WIDESCREEN", "WS"})
 	kwm.add(elementCategoryReleaseGroup, keywordOptionsDefault, []string{
 		"THORA", "HORRIBLESUBS", "ERAI-RAWS", "SUBSPLEASE"})
 	kwm.add(elementCategoryReleaseInformation, keywordOptionsDefault, []string{
-		"BATCH", "COMPLETE", "PATCH", "REMUX"})
+		"BATCH", "COMPLETE", "PATCH", "PROPER", "REMUX", "REPACK"})
 	kwm.add(elementCategoryReleaseInformation, keywordOptionsUnidentifiable, []string{
-		"END", "FINAL"}) // e.g "The End of Evangelion", "Final Approach"
+		"END", "FINAL", "FIXED"}) // e.g "The End of Evangelion", "Final Approach"
 	kwm.add(elementCategoryReleaseVersion, keywordOptionsDefault, []string{
 		"V0", "V1", "V2", "V3", "V4"})
 	kwm.add(elementCategorySource, keywordOptionsDefault, []string{
 		"BD", "BDRIP", "BLURAY", "BLU-RAY", "DVD", "DVD5", "DVD9",
 		"DVD-R2J", "DVDRIP", "DVD-RIP", "R2DVD", "R2J", "R2JDVD",
 		"R2JDVDRIP", "HDTV", "HDTVRIP", "TVRIP", "TV-RIP",
-		"WEBCAST", "WEBRIP"})
+		"WEBCAST", "WEBRIP", "WEB-RIP", "WEB-DL", "WEBDL"})
+	kwm.add(elementCategorySource, keywordOptionsAmbiguous, []string{
+		"WEB"})
+	kwm.add(elementCategoryStreamingService, keywordOptionsDefault, []string{
+		"AMZN", "DSNP", "DSNY", "NFLX", "HMAX", "ATVP", "PCOK", "HULU",
+		"CRUNCHYROLL", "FUNIMATION", "HIDIVE", "WAKANIM", "B-GLOBAL", "BILIBILI",
+		"ABEMA", "BAHA", "IQIYI"})
+	kwm.add(elementCategoryStreamingService, keywordOptionsAmbiguous, []string{
+		"CR", "NF", "AMZ", "ADN", "FUNI", "HIDI", "VRV", "DSN", "ANIPLUS",
+		"NETFLIX", "AMAZON"}) // e.g "CR" in "Title CR 01"
 	kwm.add(elementCategorySubtitles, keywordOptionsDefault, []string{
 		"ASS", "BIG5", "DUB", "DUBBED", "HARDSUB", "HARDSUBS", "RAW",
-		"SOFTSUB", "SOFTSUBS", "SUB", "SUBBED", "SUBTITLED", "MULTISUB"})
+		"SOFTSUB", "SOFTSUBS", "SUB", "SUBBED", "SUBTITLED", "MULTISUB", "MULTISUBS", "MULTI-SUB", "MULTI-SUBS",
+		"ENGSUB", "ENGSUBS", "ENG-SUB", "ENG-SUBS", "CHS", "CHT", "VIETSUB", "ซับไทย"})
+	kwm.add(elementCategorySubtitles, keywordOptionsUnidentifiable, []string{
+		"GB"})
 	kwm.add(elementCategoryVideoTerm, keywordOptionsDefault, []string{
 		// Frame rate
 		"23.976FPS", "24FPS", "29.97FPS", "30FPS", "60FPS", "120FPS",
 		// Video codec
 		"8BIT", "8-BIT", "10BIT", "10BITS", "10-BIT", "10-BITS",
 		"HI10", "HI10P", "HI444", "HI444P", "HI444PP",
-		"H264", "H265", "H.264", "H.265", "X264", "X265", "X.264",
+		"H264", "H265", "H.264", "H.265", "X264", "X265", "X.264", "X.265",
 		"AVC", "HEVC", "HEVC2", "DIVX", "DIVX5", "DIVX6", "XVID",
-		"AV1",
-		"HDR", "DV", "DOLBY VISION",
+		"AV1", "VP9",
+		"HDR", "HDR10", "DV", "DOLBY VISION",
 		// Video format
 		"AVI", "RMVB", "WMV", "WMV3", "WMV9",
 		// Video quality
 		"HQ", "LQ",
 		// Video resolution
-		"HD", "SD", "4K"})
+		"HD", "SD", "4K", "UHD", "FHD"})
 	kwm.add(elementCategoryVolumePrefix, keywordOptionsDefault, []string{
-		"VOL", "VOL.", "VOLUME", "VOLUMES"})
+		"VOL", "VOL.", "VOLUME", "VOLUMES", "VOLUMEN", "VOLUMENES", "TOME", "TOMES"})
+
+	kwm.setScannable()
 
 	return kwm
 }
@@ -192,34 +244,149 @@ func (kwm *keywordManager) findWithoutCategory(word string) (keyword, bool) {
 	return keyword{}, false
 }
 
-func (kwm *keywordManager) peek(word string, e *Elements) indexSets {
-	entries := map[elementCategory][]string{
-		elementCategoryAudioTerm:       {"Dual Audio", "DualAudio"},
-		elementCategoryVideoTerm:       {"H264", "H.264", "h264", "h.264"},
-		elementCategoryVideoResolution: {"480p", "720p", "1080p", "2160p"},
-		elementCategorySource:          {"Blu-Ray"},
+// scan looks for technical keywords and resolutions in a text before it is split by delimiters, and
+// returns their positions so that they become tokens of their own.
+//
+// Starting at each word, the longest keyword or resolution is matched repeatedly, optionally joined by dashes,
+// e.g "1080pHEVC", "BD1080p", "x264-10bit", "WEB-DL1080p". The matches are only kept if they cover the word up to
+// a delimiter or a dash, and if they would otherwise be split by the tokenizer: either the word is a compound of
+// several keywords or a keyword contains a delimiter, e.g "AAC2.0", "H.264", "Dual Audio".
+//
+// Resolutions are identified right away, so that they are not mistaken for numbers. Keywords are identified
+// in token order by the keyword search. Scene-style names are scanned segment by segment, see tokenizeSceneName.
+func (kwm *keywordManager) scan(text, delimiters string, e *Elements) indexSets {
+	preIdentifiedTokens := indexSets{}
+
+	isWordEnd := func(pos int) bool {
+		return pos >= len(text) || strings.IndexByte(delimiters, text[pos]) != -1
 	}
 
-	preIdentifiedTokens := indexSets{}
+	pos := 0
+	for pos < len(text) {
+		if pos > 0 && strings.IndexByte(delimiters, text[pos-1]) == -1 {
+			pos++
+			continue
+		}
+
+		var matches indexSets
+		spansDelimiter := false
+		cur := pos
+		for cur < len(text) {
+			length, cat := kwm.matchLongest(text[cur:])
+			if length == 0 {
+				break
+			}
+			matches = append(matches, indexSet{cur, cur + length, cat})
+			if strings.ContainsAny(text[cur:cur+length], delimiters) {
+				spansDelimiter = true
+			}
+			cur += length
+			// Keywords can be joined by a dash, e.g "x264-10bit"
+			if cur < len(text) && text[cur] == '-' {
+				if next, _ := kwm.matchLongest(text[cur+1:]); next > 0 {
+					cur++
+				}
+			}
+		}
+
+		if len(matches) == 0 || (!isWordEnd(cur) && text[cur] != '-') {
+			pos++
+			continue
+		}
+		// e.g "1080p"
+		if len(matches) == 1 && matches[0].category == elementCategoryVideoResolution {
+			spansDelimiter = true
+		}
+		if len(matches) < 2 && !spansDelimiter {
+			pos = cur
+			continue
+		}
 
-	for cat, keywords := range entries {
-		for _, kw := range keywords {
-			keywordbeginPos := strings.Index(word, kw)
-			if keywordbeginPos != -1 {
-				e.insert(cat, kw)
-				keywordendPos := keywordbeginPos + len(kw)
-				preIdentifiedTokens = append(preIdentifiedTokens, indexSet{keywordbeginPos, keywordendPos})
+		for _, m := range matches {
+			if m.category != elementCategoryVideoResolution {
+				m.category = elementCategoryUnknown
+			} else if e.contains(elementCategoryVideoResolution) {
+				m.category = elementCategoryUnknown
+			} else {
+				e.insert(elementCategoryVideoResolution, text[m.beginPos:m.endPos])
 			}
+			preIdentifiedTokens = append(preIdentifiedTokens, m)
 		}
+		pos = cur
 	}
+
 	sort.Sort(preIdentifiedTokens)
 	return preIdentifiedTokens
 }
 
+// e.g "1080p", "1080i", "1920x1080" at the start of a text
+var scanResolutionRe = regexp.MustCompile(`^(?:\d{3,4}[xX\x{00D7}]\d{3,4}|\d{3,4}[pPiI])`)
+
+// matchLongest returns the length and category of the longest technical keyword or resolution the text starts with.
+func (kwm *keywordManager) matchLongest(text string) (int, elementCategory) {
+	length, cat := 0, elementCategoryUnknown
+	for _, kw := range kwm.scannable {
+		if len(kw) <= len(text) && strings.EqualFold(text[:len(kw)], kw) {
+			length, cat = len(kw), kwm.keywords[kw].category
+			break
+		}
+	}
+	if loc := scanResolutionRe.FindStringIndex(text); loc != nil && loc[1] > length {
+		length, cat = loc[1], elementCategoryVideoResolution
+	}
+	return length, cat
+}
+
+// Categories of the keywords looked for by scan
+var scannableCategories = []elementCategory{
+	elementCategoryAudioTerm,
+	elementCategoryReleaseInformation,
+	elementCategorySource,
+	elementCategoryStreamingService,
+	elementCategoryVideoTerm,
+}
+
+// setScannable builds the list of keywords looked for by scan, from the longest to the shortest.
+func (kwm *keywordManager) setScannable() {
+	kwm.scannable = nil
+	for kw, kd := range kwm.keywords {
+		if kd.options != keywordOptionsDefault || !checkCategoryInList(scannableCategories, kd.category) {
+			continue
+		}
+		kwm.scannable = append(kwm.scannable, kw)
+	}
+	sort.Slice(kwm.scannable, func(i, j int) bool {
+		if len(kwm.scannable[i]) != len(kwm.scannable[j]) {
+			return len(kwm.scannable[i]) > len(kwm.scannable[j])
+		}
+		return kwm.scannable[i] < kwm.scannable[j]
+	})
+}
+
+func checkCategoryInList(list []elementCategory, cat elementCategory) bool {
+	for _, v := range list {
+		if v == cat {
+			return true
+		}
+	}
+	return false
+}
+
+// normalize returns the upper case form of a word without its diacritics, e.g "Épisode" is "EPISODE".
 func (kwm *keywordManager) normalize(text string) string {
 	f := norm.Form(3)
 
-	return strings.ToUpper(string(f.Bytes([]byte(text))))
+	decomposed := f.String(text)
+	text = strings.Map(func(r rune) rune {
+		// Combining diacritical marks left over by the decomposition
+		if r >= '\u0300' && r <= '\u036F' {
+			return -1
+		}
+		return r
+	}, decomposed)
+
+	// Recompose Hangul syllables and kana, e.g "시즌", "ダブル"
+	return strings.ToUpper(norm.NFC.String(text))
 }
 
 func (idxSet indexSets) Len() int {
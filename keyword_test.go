@@ -64,10 +64,10 @@ func TestKeywordFindWithoutCategory(t *testing.T) {
 	}
 }
 
-func TestKeywordPeek(t *testing.T) {
+func TestKeywordScan(t *testing.T) {
 	psr := getTestParser("")
 	testStr := "this is a Dual Audio"
-	idxSets := psr.tokenizer.keywordManager.peek(testStr, psr.tokenizer.elements)
+	idxSets := psr.tokenizer.keywordManager.scan(testStr, DefaultOptions.AllowedDelimiters, psr.tokenizer.elements)
 	if idxSets[0].beginPos != 10 {
 		t.Errorf("expected 10, got %d", idxSets[0].beginPos)
 	}
@@ -78,3 +78,108 @@ func TestKeywordPeek(t *testing.T) {
 		t.Errorf("expected \"%s\", got \"%s\"", "Dual Audio", testStr[idxSets[0].beginPos:idxSets[0].endPos])
 	}
 }
+
+func TestKeywordNormalize(t *testing.T) {
+	kwm := newKeywordManager()
+	testCases := map[string]string{"Épisode": "EPISODE", "Capítulo": "CAPITULO", "2ª": "2A", "folge": "FOLGE", "시즌": "시즌"}
+	for str, expected := range testCases {
+		ret := kwm.normalize(str)
+		if ret != expected {
+			t.Errorf("expected \"%s\", got \"%s\"", expected, ret)
+		}
+	}
+}
+
+func TestKeywordScanCompound(t *testing.T) {
+	testCases := []struct {
+		text     string
+		expected []string
+	}{
+		{"1080pHEVC", []string{"1080p", "HEVC"}},
+		{"BD1080p", []string{"BD", "1080p"}},
+		{"DDP5.1", []string{"DDP5.1"}},
+		{"x264-10bit", []string{"x264", "10bit"}},
+		{"WEB-DL1080p HEVC-10bit", []string{"WEB-DL", "1080p", "HEVC", "10bit"}},
+		{"HEVC", []string{}},
+		{"Titans", []string{}},
+		{"HDTVirus", []string{}},
+	}
+	for _, tc := range testCases {
+		kwm := newKeywordManager()
+		idxSets := kwm.scan(tc.text, DefaultOptions.AllowedDelimiters, &Elements{})
+		var ret []string
+		for _, idxSet := range idxSets {
+			ret = append(ret, tc.text[idxSet.beginPos:idxSet.endPos])
+		}
+		if len(ret) != len(tc.expected) {
+			t.Errorf("%s: expected %v, got %v", tc.text, tc.expected, ret)
+			continue
+		}
+		for i := range ret {
+			if ret[i] != tc.expected[i] {
+				t.Errorf("%s: expected %v, got %v", tc.text, tc.expected, ret)
+				break
+			}
+		}
+	}
+}
+
+func TestKeywordScanSceneName(t *testing.T) {
+	tkz := tokenizer{
+		tokens:         &tokens{},
+		keywordManager: newKeywordManager(),
+		elements:       &Elements{},
+		options:        DefaultOptions,
+	}
+	tkz.tokenizeSceneName("Spy.x.Family.S01.BluRay.x264-10bit")
+	expected := []string{"Spy", ".", "x", ".", "Family", ".", "S01", ".", "BluRay", ".", "x264", "-", "10bit"}
+	if len(*tkz.tokens) != len(expected) {
+		t.Fatalf("expected %d tokens, got %d", len(expected), len(*tkz.tokens))
+	}
+	for i, v := range *tkz.tokens {
+		if v.Content != expected[i] {
+			t.Errorf("expected \"%s\", got \"%s\"", expected[i], v.Content)
+		}
+	}
+
+	options := DefaultOptions
+	options.SceneNaming = SceneNamingAlways
+	e := Parse("Spy.x.Family.S01.1080p.BluRay.HEVC-10bit.AAC2.0.mkv", options)
+	if len(e.VideoTerm) != 2 || e.VideoTerm[0] != "HEVC" || e.VideoTerm[1] != "10bit" {
+		t.Errorf("expected [HEVC 10bit], got %v", e.VideoTerm)
+	}
+	if len(e.Unknown) != 0 {
+		t.Errorf("expected [], got %v", e.Unknown)
+	}
+}
+
+func TestKeywordScanResolution(t *testing.T) {
+	kwm := newKeywordManager()
+	e := &Elements{}
+	idxSets := kwm.scan("BD1080p", DefaultOptions.AllowedDelimiters, e)
+	if e.VideoResolution != "1080p" {
+		t.Errorf("expected \"1080p\", got \"%s\"", e.VideoResolution)
+	}
+	if idxSets[0].category != elementCategoryUnknown {
+		t.Errorf("expected %d, got %d", elementCategoryUnknown, idxSets[0].category)
+	}
+	if idxSets[1].category != elementCategoryVideoResolution {
+		t.Errorf("expected %d, got %d", elementCategoryVideoResolution, idxSets[1].category)
+	}
+}
+
+func TestKeywordMatchLongest(t *testing.T) {
+	kwm := newKeywordManager()
+	length, cat := kwm.matchLongest("WEB-DL1080p")
+	if length != 6 || cat != elementCategorySource {
+		t.Errorf("expected 6 %d, got %d %d", elementCategorySource, length, cat)
+	}
+	length, cat = kwm.matchLongest("1080pHEVC")
+	if length != 5 || cat != elementCategoryVideoResolution {
+		t.Errorf("expected 5 %d, got %d %d", elementCategoryVideoResolution, length, cat)
+	}
+	length, _ = kwm.matchLongest("Title")
+	if length != 0 {
+		t.Errorf("expected 0, got %d", length)
+	}
+}
@@ -0,0 +1,204 @@
+package tanuki
+
+import (
+	"regexp"
+	"strings"
+)
+
+// languageTag describes what a language keyword tells about the audio and subtitle languages of a release.
+type languageTag struct {
+	// BCP-47 code of the language, e.g "en", "pt-BR", "zh-Hant".
+	// Its kind (audio or subtitles) depends on the surrounding tokens, e.g "[ENG SUB]", "[ENG DUB]".
+	code string
+
+	// True if a lone language tag is an audio language rather than a subtitle language.
+	// e.g "[JPN]" describes the audio, while "[ENG]" or "[ITA]" describe the subtitles of a fansub.
+	audio bool
+
+	// Languages implied by the keyword itself, regardless of the surrounding tokens,
+	// e.g "VOSTFR" is Japanese audio with French subtitles.
+	impliedAudio     []string
+	impliedSubtitles []string
+}
+
+const (
+	languageEnglish            = "en"
+	languageJapanese           = "ja"
+	languageSpanish            = "es"
+	languageSpanishLatin       = "es-419"
+	languagePortuguese         = "pt"
+	languagePortugueseBrazil   = "pt-BR"
+	languageFrench             = "fr"
+	languageGerman             = "de"
+	languageItalian            = "it"
+	languageRussian            = "ru"
+	languageChinese            = "zh"
+	languageChineseSimplified  = "zh-Hans"
+	languageChineseTraditional = "zh-Hant"
+	languageKorean             = "ko"
+	languageArabic             = "ar"
+	languageIndonesian         = "id"
+	languageVietnamese         = "vi"
+	languageThai               = "th"
+	languageMultiple           = "mul"
+)
+
+// Language tags indexed by normalized keyword.
+// Every keyword should also be registered in newKeywordManager, except "MULTI" and "MULTIPLE"
+// which are too common in titles and only count when enclosed, e.g "[Multiple Subtitle]".
+var languageTags = map[string]languageTag{
+	"ENG": {code: languageEnglish}, "ENGLISH": {code: languageEnglish},
+	"JAP": {code: languageJapanese, audio: true}, "JPN": {code: languageJapanese, audio: true},
+	"JAPANESE": {code: languageJapanese, audio: true},
+	"ESP":      {code: languageSpanish}, "SPA": {code: languageSpanish},
+	"ESPANOL": {code: languageSpanish}, "SPANISH": {code: languageSpanish}, "CASTELLANO": {code: languageSpanish},
+	"LAT": {code: languageSpanishLatin}, "LATINO": {code: languageSpanishLatin},
+	"POR": {code: languagePortuguese}, "PORTUGUESE": {code: languagePortuguese},
+	"PT-BR": {code: languagePortugueseBrazil}, "POR-BR": {code: languagePortugueseBrazil},
+	"FRE": {code: languageFrench}, "FRA": {code: languageFrench}, "FRENCH": {code: languageFrench},
+	"GER": {code: languageGerman}, "DEU": {code: languageGerman}, "GERMAN": {code: languageGerman},
+	"DEUTSCH": {code: languageGerman},
+	"ITA":     {code: languageItalian}, "ITALIAN": {code: languageItalian},
+	"RUS": {code: languageRussian}, "RUSSIAN": {code: languageRussian},
+	"CHI": {code: languageChinese}, "CHINESE": {code: languageChinese},
+	"KOR": {code: languageKorean}, "KOREAN": {code: languageKorean},
+	"ARA": {code: languageArabic}, "ARABIC": {code: languageArabic},
+	"INDONESIAN": {code: languageIndonesian}, "VIETNAMESE": {code: languageVietnamese},
+	"THAI":     {code: languageThai},
+	"MULTI":    {code: languageMultiple},
+	"MULTIPLE": {code: languageMultiple},
+
+	// Chinese subtitle scripts
+	"CHS":  {impliedSubtitles: []string{languageChineseSimplified}},
+	"GB":   {impliedSubtitles: []string{languageChineseSimplified}},
+	"CHT":  {impliedSubtitles: []string{languageChineseTraditional}},
+	"BIG5": {impliedSubtitles: []string{languageChineseTraditional}},
+
+	// Release conventions
+	"VOSTFR":      {impliedAudio: []string{languageJapanese}, impliedSubtitles: []string{languageFrench}},
+	"VOST":        {impliedAudio: []string{languageJapanese}, impliedSubtitles: []string{languageFrench}},
+	"VF":          {impliedAudio: []string{languageFrench}},
+	"VFF":         {impliedAudio: []string{languageFrench}},
+	"DUALAUDIO":   {impliedAudio: []string{languageJapanese, languageEnglish}},
+	"DUAL-AUDIO":  {impliedAudio: []string{languageJapanese, languageEnglish}},
+	"DUAL AUDIO":  {impliedAudio: []string{languageJapanese, languageEnglish}},
+	"MULTI-AUDIO": {impliedAudio: []string{languageMultiple}},
+	"MULTISUB":    {impliedSubtitles: []string{languageMultiple}},
+	"MULTISUBS":   {impliedSubtitles: []string{languageMultiple}},
+	"MULTI-SUB":   {impliedSubtitles: []string{languageMultiple}},
+	"MULTI-SUBS":  {impliedSubtitles: []string{languageMultiple}},
+
+	// Russian releases, e.g "[Озвучка]" is a Russian voice-over
+	"ОЗВУЧКА":  {impliedAudio: []string{languageRussian}},
+	"ДУБЛЯЖ":   {impliedAudio: []string{languageRussian}},
+	"СУБТИТРЫ": {impliedSubtitles: []string{languageRussian}},
+
+	// Southeast Asian releases
+	"VIETSUB": {impliedSubtitles: []string{languageVietnamese}},
+	"ซับไทย":  {impliedSubtitles: []string{languageThai}},
+
+	// Korean releases
+	"자막":   {impliedSubtitles: []string{languageKorean}},
+	"한글자막": {impliedSubtitles: []string{languageKorean}},
+}
+
+// Short language codes only recognized after a subtitle keyword, e.g "Sub.FR", "Sub{Fr}"
+var subtitleLanguageCodes = map[string]string{
+	"EN": languageEnglish, "JP": languageJapanese, "ES": languageSpanish, "SP": languageSpanish,
+	"PT": languagePortuguese, "BR": languagePortugueseBrazil, "FR": languageFrench,
+	"DE": languageGerman, "IT": languageItalian, "RU": languageRussian,
+	"CH": languageChinese, "ZH": languageChinese, "KO": languageKorean, "AR": languageArabic,
+	"ID": languageIndonesian, "INDO": languageIndonesian, "INDONESIA": languageIndonesian,
+	"VI": languageVietnamese, "VIET": languageVietnamese, "TH": languageThai,
+}
+
+// e.g "ENGSUB", "ENG-SUBS", "ITA-DUB"
+var languageKindSuffixRe = regexp.MustCompile(`^(.+?)-?(SUBS?|SUBBED|SUBTITLES?|DUBS?|DUBBED)$`)
+
+func isSubtitleWord(w string) bool {
+	switch w {
+	case "SUB", "SUBS", "SUBBED", "SUBTITLE", "SUBTITLES", "SUBTITLED", "SOFTSUB", "SOFTSUBS", "HARDSUB", "HARDSUBS":
+		return true
+	}
+	return false
+}
+
+func isDubWord(w string) bool {
+	switch w {
+	case "DUB", "DUBS", "DUBBED", "AUDIO":
+		return true
+	}
+	return false
+}
+
+// splitLanguageKind splits a compound token like "ENGSUB" into its language tag and kind.
+func splitLanguageKind(w string) (languageTag, string, bool) {
+	match := languageKindSuffixRe.FindStringSubmatch(w)
+	if match == nil {
+		return languageTag{}, "", false
+	}
+	tag, found := languageTags[match[1]]
+	if !found || tag.code == "" {
+		return languageTag{}, "", false
+	}
+	return tag, match[2], true
+}
+
+func appendLanguages(list []string, codes ...string) []string {
+	for _, code := range codes {
+		if !checkInList(list, code) {
+			list = append(list, code)
+		}
+	}
+	return list
+}
+
+// subtitleLanguageList returns the languages in a token following a subtitle keyword, e.g "FR" or "JP-EN-FR"
+func subtitleLanguageList(w string) ([]string, bool) {
+	if w == "" {
+		return nil, false
+	}
+	var codes []string
+	for _, part := range strings.Split(w, "-") {
+		if code, found := subtitleLanguageCodes[part]; found {
+			codes = append(codes, code)
+		} else if tag, found := languageTags[part]; found && tag.code != "" {
+			codes = append(codes, tag.code)
+		} else {
+			return nil, false
+		}
+	}
+	return codes, true
+}
+
+// Chinese subtitle tags, e.g "简繁内封", "简体内嵌", "简日双语", "繁體中文字幕"
+var cjkSubtitleTagRe = regexp.MustCompile(`^(?:简体|繁体|簡體|繁體|简中|繁中|簡中|中文|双语|雙語|内封|內封|内嵌|內嵌|外挂|外掛|字幕|中字|[简簡繁日中英])+$`)
+
+func isCJKSubtitleTag(w string) bool {
+	return cjkSubtitleTagRe.MatchString(w)
+}
+
+// cjkSubtitleLanguages returns the languages of a Chinese subtitle tag,
+// e.g "简繁日内封字幕" is []string{"zh-Hans", "zh-Hant", "ja"}
+func cjkSubtitleLanguages(w string) []string {
+	var codes []string
+	chinese := false
+	for _, r := range w {
+		switch r {
+		case '简', '簡':
+			codes = appendLanguages(codes, languageChineseSimplified)
+			chinese = true
+		case '繁':
+			codes = appendLanguages(codes, languageChineseTraditional)
+			chinese = true
+		case '日':
+			codes = appendLanguages(codes, languageJapanese)
+		case '英':
+			codes = appendLanguages(codes, languageEnglish)
+		}
+	}
+	if !chinese && strings.ContainsRune(w, '中') {
+		codes = append([]string{languageChinese}, codes...)
+	}
+	return codes
+}
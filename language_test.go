@@ -0,0 +1,138 @@
+package tanuki
+
+import (
+	"testing"
+)
+
+func TestLanguageKeywords(t *testing.T) {
+	kwm := newKeywordManager()
+	for kw := range languageTags {
+		if kw == "DUAL AUDIO" { // Pre-identified by the keyword manager
+			continue
+		}
+		if kw == "MULTI" || kw == "MULTIPLE" { // Only languages when enclosed
+			continue
+		}
+		if _, found := kwm.findWithoutCategory(kw); !found {
+			t.Errorf("expected \"%s\" to be a keyword", kw)
+		}
+	}
+}
+
+func TestLanguageTitleWords(t *testing.T) {
+	e := Parse("[SubsPlease] The Italian Job - 01 (1080p).mkv", DefaultOptions)
+	if e.AnimeTitle != "The Italian Job" {
+		t.Errorf("expected \"The Italian Job\", got \"%s\"", e.AnimeTitle)
+	}
+	if len(e.SubtitleLanguages) != 0 {
+		t.Errorf("expected [], got %v", e.SubtitleLanguages)
+	}
+	e = Parse("Multiple Personality Girl - 01.mkv", DefaultOptions)
+	if e.AnimeTitle != "Multiple Personality Girl" {
+		t.Errorf("expected \"Multiple Personality Girl\", got \"%s\"", e.AnimeTitle)
+	}
+	if len(e.Language) != 0 || len(e.SubtitleLanguages) != 0 {
+		t.Errorf("expected [], got %v %v", e.Language, e.SubtitleLanguages)
+	}
+	e = Parse("[Erai-raws] Blue Lock - 01 [Multiple Subtitle]", DefaultOptions)
+	if len(e.Language) != 0 {
+		t.Errorf("expected [], got %v", e.Language)
+	}
+	if len(e.SubtitleLanguages) != 1 || e.SubtitleLanguages[0] != languageMultiple {
+		t.Errorf("expected [mul], got %v", e.SubtitleLanguages)
+	}
+	e = Parse("[Group] Title - 01 [French].mkv", DefaultOptions)
+	if len(e.SubtitleLanguages) != 1 || e.SubtitleLanguages[0] != languageFrench {
+		t.Errorf("expected [fr], got %v", e.SubtitleLanguages)
+	}
+}
+
+func TestLanguageSplitLanguageKind(t *testing.T) {
+	_, _, found := splitLanguageKind("TESTSUB")
+	if found {
+		t.Error("expected false, got true")
+	}
+	tag, kind, found := splitLanguageKind("ENG-SUBS")
+	if !found || tag.code != languageEnglish || kind != "SUBS" {
+		t.Errorf("expected (\"en\", \"SUBS\"), got (\"%s\", \"%s\")", tag.code, kind)
+	}
+	tag, kind, found = splitLanguageKind("ITADUB")
+	if !found || tag.code != languageItalian || kind != "DUB" {
+		t.Errorf("expected (\"it\", \"DUB\"), got (\"%s\", \"%s\")", tag.code, kind)
+	}
+}
+
+func TestLanguageSubtitleLanguageList(t *testing.T) {
+	_, found := subtitleLanguageList("TEST")
+	if found {
+		t.Error("expected false, got true")
+	}
+	codes, found := subtitleLanguageList("JP-EN-FR")
+	if !found || !equal(codes, []string{"ja", "en", "fr"}) {
+		t.Errorf("expected [ja en fr], got %v", codes)
+	}
+}
+
+func TestLanguageParse(t *testing.T) {
+	testCases := []struct {
+		filename  string
+		audio     []string
+		subtitles []string
+	}{
+		{"[Group] Title - 01 [ENG SUB].mkv", nil, []string{"en"}},
+		{"[Group] Title - 01 [ENG DUB].mkv", []string{"en"}, nil},
+		{"[Group] Title - 01 [1080p][Multi Subs].mkv", nil, []string{"mul"}},
+		{"[Group] Title - 01 [Dual-Audio].mkv", []string{"ja", "en"}, nil},
+		{"[Group] Title - 01 [ITA].mkv", nil, []string{"it"}},
+		{"[Group] Title - 01 [PT-BR].mkv", nil, []string{"pt-BR"}},
+		{"[Group] Title - 01 [CHS][CHT].mkv", nil, []string{"zh-Hans", "zh-Hant"}},
+		{"[Group] Title - 01 [BIG5].mkv", nil, []string{"zh-Hant"}},
+		{"Episode 14 Ore no Imouto ga Konnani Kawaii Wake ga Nai. (saison 2) VOSTFR", []string{"ja"}, []string{"fr"}},
+		{"[Juuni.Kokki]-(Les.12.Royaumes)-[Ep.24]-[x264+OGG]-[JAP+FR+Sub.FR]-[Chap]-[AzF].mkv", []string{"ja"}, []string{"fr"}},
+		{"Bokura ga Ita - 01.mkv", nil, nil},
+	}
+	for _, tc := range testCases {
+		e := Parse(tc.filename, DefaultOptions)
+		if !equal(e.AudioLanguages, tc.audio) {
+			t.Errorf("%s: expected audio languages %v, got %v", tc.filename, tc.audio, e.AudioLanguages)
+		}
+		if !equal(e.SubtitleLanguages, tc.subtitles) {
+			t.Errorf("%s: expected subtitle languages %v, got %v", tc.filename, tc.subtitles, e.SubtitleLanguages)
+		}
+	}
+}
+
+func TestLanguageCJKSubtitleLanguages(t *testing.T) {
+	if isCJKSubtitleTag("桜都字幕组") {
+		t.Error("expected false, got true")
+	}
+	if !isCJKSubtitleTag("简繁日内封字幕") {
+		t.Error("expected true, got false")
+	}
+	codes := cjkSubtitleLanguages("简繁日内封字幕")
+	if !equal(codes, []string{"zh-Hans", "zh-Hant", "ja"}) {
+		t.Errorf("expected [zh-Hans zh-Hant ja], got %v", codes)
+	}
+	codes = cjkSubtitleLanguages("中文字幕")
+	if !equal(codes, []string{"zh"}) {
+		t.Errorf("expected [zh], got %v", codes)
+	}
+}
+
+func TestLanguageSoutheastAsianSubtitles(t *testing.T) {
+	codes, found := subtitleLanguageList("INDO")
+	if !found || !equal(codes, []string{"id"}) {
+		t.Errorf("expected [id], got %v", codes)
+	}
+	e := Parse("Jujutsu Kaisen Tập 5 Vietsub.mp4", DefaultOptions)
+	if !equal(e.SubtitleLanguages, []string{"vi"}) {
+		t.Errorf("expected [vi], got %v", e.SubtitleLanguages)
+	}
+	if len(e.EpisodeNumber) != 1 || e.EpisodeNumber[0] != "5" {
+		t.Errorf("expected [5], got %v", e.EpisodeNumber)
+	}
+	e = Parse("[Group] Jujutsu Kaisen ตอนที่ 5 ซับไทย [1080p].mkv", DefaultOptions)
+	if !equal(e.SubtitleLanguages, []string{"th"}) {
+		t.Errorf("expected [th], got %v", e.SubtitleLanguages)
+	}
+}
@@ -0,0 +1,187 @@
+package tanuki
+
+import (
+	"regexp"
+)
+
+// VideoCodec is the canonical name of a video codec, e.g "H.264", "h264" and "x264" are all VideoCodecAVC.
+type VideoCodec string
+
+const (
+	VideoCodecAVC  VideoCodec = "AVC"
+	VideoCodecHEVC VideoCodec = "HEVC"
+	VideoCodecAV1  VideoCodec = "AV1"
+	VideoCodecVP9  VideoCodec = "VP9"
+	VideoCodecXviD VideoCodec = "XviD"
+	VideoCodecDivX VideoCodec = "DivX"
+	VideoCodecWMV  VideoCodec = "WMV"
+)
+
+// HDRFormat is the canonical name of a high dynamic range format.
+type HDRFormat string
+
+const (
+	HDRFormatHDR         HDRFormat = "HDR"
+	HDRFormatHDR10       HDRFormat = "HDR10"
+	HDRFormatDolbyVision HDRFormat = "DV"
+)
+
+// AudioCodec is the canonical name of an audio codec, e.g "EAC3", "E-AC-3" and "DDP" are all AudioCodecEAC3.
+type AudioCodec string
+
+const (
+	AudioCodecAAC    AudioCodec = "AAC"
+	AudioCodecAC3    AudioCodec = "AC3"
+	AudioCodecEAC3   AudioCodec = "EAC3"
+	AudioCodecDTS    AudioCodec = "DTS"
+	AudioCodecDTSHD  AudioCodec = "DTS-HD"
+	AudioCodecTrueHD AudioCodec = "TrueHD"
+	AudioCodecFLAC   AudioCodec = "FLAC"
+	AudioCodecOpus   AudioCodec = "Opus"
+	AudioCodecVorbis AudioCodec = "Vorbis"
+	AudioCodecMP3    AudioCodec = "MP3"
+	AudioCodecPCM    AudioCodec = "PCM"
+)
+
+// AudioTrack is an audio codec along with its channel layout, e.g "DTS5.1" is {Codec: "DTS", Channels: "5.1"}.
+type AudioTrack struct {
+	Codec AudioCodec `json:"codec,omitempty"`
+
+	// Channel layout of the track, e.g "2.0", "5.1". Empty if not specified in the filename.
+	Channels string `json:"channels,omitempty"`
+}
+
+// SourceType is the canonical kind of medium the video was ripped from.
+type SourceType string
+
+const (
+	SourceTypeBD  SourceType = "BD"
+	SourceTypeDVD SourceType = "DVD"
+	SourceTypeWEB SourceType = "WEB"
+	SourceTypeTV  SourceType = "TV"
+)
+
+// MediaKind is the kind of video the file is, e.g an episode, a movie or a creditless opening.
+type MediaKind string
+
+const (
+	MediaKindEpisode    MediaKind = "episode"
+	MediaKindMovie      MediaKind = "movie"
+	MediaKindOVA        MediaKind = "OVA"
+	MediaKindONA        MediaKind = "ONA"
+	MediaKindSpecial    MediaKind = "special"
+	MediaKindRecap      MediaKind = "recap"
+	MediaKindOpening    MediaKind = "opening"
+	MediaKindEnding     MediaKind = "ending"
+	MediaKindPreview    MediaKind = "preview"
+	MediaKindCommercial MediaKind = "CM"
+	MediaKindMenu       MediaKind = "menu"
+)
+
+// The following tables map normalized keywords to their canonical value.
+// Every keyword should also be registered under the matching category in newKeywordManager.
+
+var videoCodecs = map[string]VideoCodec{
+	"H264": VideoCodecAVC, "H.264": VideoCodecAVC, "X264": VideoCodecAVC, "X.264": VideoCodecAVC, "AVC": VideoCodecAVC,
+	"H265": VideoCodecHEVC, "H.265": VideoCodecHEVC, "X265": VideoCodecHEVC, "X.265": VideoCodecHEVC,
+	"HEVC": VideoCodecHEVC, "HEVC2": VideoCodecHEVC,
+	"AV1":  VideoCodecAV1,
+	"VP9":  VideoCodecVP9,
+	"XVID": VideoCodecXviD,
+	"DIVX": VideoCodecDivX, "DIVX5": VideoCodecDivX, "DIVX6": VideoCodecDivX,
+	"WMV": VideoCodecWMV, "WMV3": VideoCodecWMV, "WMV9": VideoCodecWMV,
+}
+
+var videoBitDepths = map[string]int{
+	"8BIT": 8, "8-BIT": 8,
+	"10BIT": 10, "10BITS": 10, "10-BIT": 10, "10-BITS": 10,
+	"HI10": 10, "HI10P": 10,
+}
+
+var hdrFormats = map[string]HDRFormat{
+	"HDR":          HDRFormatHDR,
+	"HDR10":        HDRFormatHDR10,
+	"DV":           HDRFormatDolbyVision,
+	"DOLBY VISION": HDRFormatDolbyVision,
+}
+
+var audioCodecs = map[string]AudioCodec{
+	"AAC": AudioCodecAAC, "AACX2": AudioCodecAAC, "AACX3": AudioCodecAAC, "AACX4": AudioCodecAAC,
+	"AC3": AudioCodecAC3, "DD": AudioCodecAC3,
+	"EAC3": AudioCodecEAC3, "E-AC-3": AudioCodecEAC3, "E-AC3": AudioCodecEAC3, "DDP": AudioCodecEAC3,
+	"DTS": AudioCodecDTS, "DTS-ES": AudioCodecDTS,
+	"DTS-HD": AudioCodecDTSHD, "DTS-HDMA": AudioCodecDTSHD,
+	"TRUEHD": AudioCodecTrueHD,
+	"FLAC":   AudioCodecFLAC, "FLACX2": AudioCodecFLAC, "FLACX3": AudioCodecFLAC, "FLACX4": AudioCodecFLAC,
+	"OPUS": AudioCodecOpus,
+	"OGG":  AudioCodecVorbis, "VORBIS": AudioCodecVorbis,
+	"MP3":  AudioCodecMP3,
+	"LPCM": AudioCodecPCM, "PCM": AudioCodecPCM,
+}
+
+var sourceTypes = map[string]SourceType{
+	"BD": SourceTypeBD, "BDRIP": SourceTypeBD, "BLURAY": SourceTypeBD, "BLU-RAY": SourceTypeBD,
+	"DVD": SourceTypeDVD, "DVD5": SourceTypeDVD, "DVD9": SourceTypeDVD, "DVD-R2J": SourceTypeDVD,
+	"DVDRIP": SourceTypeDVD, "DVD-RIP": SourceTypeDVD, "R2DVD": SourceTypeDVD, "R2J": SourceTypeDVD,
+	"R2JDVD": SourceTypeDVD, "R2JDVDRIP": SourceTypeDVD,
+	"HDTV": SourceTypeTV, "HDTVRIP": SourceTypeTV, "TVRIP": SourceTypeTV, "TV-RIP": SourceTypeTV,
+	"WEBCAST": SourceTypeWEB, "WEBRIP": SourceTypeWEB, "WEB-RIP": SourceTypeWEB, "WEB-DL": SourceTypeWEB,
+	"WEBDL": SourceTypeWEB, "WEB": SourceTypeWEB,
+}
+
+var mediaKinds = map[string]MediaKind{
+	"TV":         MediaKindEpisode,
+	"GEKIJOUBAN": MediaKindMovie, "MOVIE": MediaKindMovie,
+	"OAD": MediaKindOVA, "OAV": MediaKindOVA, "OVA": MediaKindOVA,
+	"ONA":     MediaKindONA,
+	"SPECIAL": MediaKindSpecial, "SPECIALS": MediaKindSpecial, "SP": MediaKindSpecial, "番外編": MediaKindSpecial,
+	"映像特典": MediaKindSpecial, "特典": MediaKindSpecial, "特典アニメ": MediaKindSpecial, "TOKUTEN": MediaKindSpecial,
+	"總集編": MediaKindRecap, "RECAP": MediaKindRecap,
+	"OP": MediaKindOpening, "OPENING": MediaKindOpening, "NCOP": MediaKindOpening, "OPED": MediaKindOpening,
+	"ED": MediaKindEnding, "ENDING": MediaKindEnding, "NCED": MediaKindEnding,
+	"PREVIEW": MediaKindPreview, "PV": MediaKindPreview, "SPOT": MediaKindPreview,
+	"CM":   MediaKindCommercial,
+	"MENU": MediaKindMenu,
+}
+
+// Media kinds in order of precedence when several are found, e.g "OVA NCOP" is an opening.
+var mediaKindPrecedence = []MediaKind{
+	MediaKindOpening, MediaKindEnding, MediaKindPreview, MediaKindCommercial, MediaKindMenu,
+	MediaKindRecap, MediaKindSpecial, MediaKindMovie, MediaKindOVA, MediaKindONA, MediaKindEpisode,
+}
+
+// e.g "DTS5.1", "TRUEHD5.1", "DD2.0", "2CH", "5.1"
+var audioChannelsRe = regexp.MustCompile(`^(.*?)(\d)(?:\.(\d))?(?:CH)?$`)
+
+// splitAudioTerm splits a normalized audio term into its codec and channel layout.
+// Either can be empty, e.g "5.1CH" has no codec and "FLAC" has no channel layout.
+func splitAudioTerm(term string) (AudioCodec, string) {
+	if codec, found := audioCodecs[term]; found {
+		return codec, ""
+	}
+	match := audioChannelsRe.FindStringSubmatch(term)
+	if match == nil {
+		return "", ""
+	}
+	channels := match[2] + ".0"
+	if match[3] != "" {
+		channels = match[2] + "." + match[3]
+	}
+	if match[1] == "" {
+		return "", channels
+	}
+	codec, found := audioCodecs[match[1]]
+	if !found {
+		return "", ""
+	}
+	return codec, channels
+}
+
+func appendHDRFormat(formats []HDRFormat, format HDRFormat) []HDRFormat {
+	for _, v := range formats {
+		if v == format {
+			return formats
+		}
+	}
+	return append(formats, format)
+}
@@ -0,0 +1,90 @@
+package tanuki
+
+import (
+	"testing"
+)
+
+func TestMediaSplitAudioTerm(t *testing.T) {
+	testCases := []struct {
+		term     string
+		codec    AudioCodec
+		channels string
+	}{
+		{"AAC", AudioCodecAAC, ""},
+		{"2.0CH", "", "2.0"},
+		{"2CH", "", "2.0"},
+		{"5.1", "", "5.1"},
+		{"DTS5.1", AudioCodecDTS, "5.1"},
+		{"TRUEHD5.1", AudioCodecTrueHD, "5.1"},
+		{"DD2.0", AudioCodecAC3, "2.0"},
+		{"DDP5.1", AudioCodecEAC3, "5.1"},
+		{"MP3", AudioCodecMP3, ""},
+		{"TEST5.1", "", ""},
+		{"DUALAUDIO", "", ""},
+	}
+	for _, tc := range testCases {
+		codec, channels := splitAudioTerm(tc.term)
+		if codec != tc.codec || channels != tc.channels {
+			t.Errorf("%s: expected (\"%s\", \"%s\"), got (\"%s\", \"%s\")", tc.term, tc.codec, tc.channels, codec, channels)
+		}
+	}
+}
+
+func TestMediaKeywords(t *testing.T) {
+	kwm := newKeywordManager()
+	for kw := range videoCodecs {
+		if _, found := kwm.find(kw, elementCategoryVideoTerm); !found {
+			t.Errorf("expected \"%s\" to be a video term", kw)
+		}
+	}
+	for kw := range audioCodecs {
+		if kw == "DD" {
+			continue
+		}
+		if _, found := kwm.find(kw, elementCategoryAudioTerm); !found {
+			t.Errorf("expected \"%s\" to be an audio term", kw)
+		}
+	}
+	for kw := range sourceTypes {
+		if _, found := kwm.find(kw, elementCategorySource); !found {
+			t.Errorf("expected \"%s\" to be a source", kw)
+		}
+	}
+}
+
+func TestMediaParse(t *testing.T) {
+	e := Parse("Byousoku 5 Centimeter [Blu-Ray][1920x1080 H.264][2.0ch AAC][SOFTSUBS]", DefaultOptions)
+	if e.VideoCodec != VideoCodecAVC {
+		t.Errorf("expected \"%s\", got \"%s\"", VideoCodecAVC, e.VideoCodec)
+	}
+	if e.SourceType != SourceTypeBD {
+		t.Errorf("expected \"%s\", got \"%s\"", SourceTypeBD, e.SourceType)
+	}
+	if len(e.AudioTracks) != 1 || e.AudioTracks[0] != (AudioTrack{AudioCodecAAC, "2.0"}) {
+		t.Errorf("expected [{AAC 2.0}], got %v", e.AudioTracks)
+	}
+
+	e = Parse("[ASW] Yami Shibai 11 - 12 [1080p HEVC x265 10Bit][AAC].mkv", DefaultOptions)
+	if e.VideoCodec != VideoCodecHEVC {
+		t.Errorf("expected \"%s\", got \"%s\"", VideoCodecHEVC, e.VideoCodec)
+	}
+	if e.VideoBitDepth != 10 {
+		t.Errorf("expected 10, got %d", e.VideoBitDepth)
+	}
+
+	e = Parse("[UTW-TMD]_Summer_Wars_[BD][h264-720p][TrueHD5.1][9F311DAB].mkv", DefaultOptions)
+	if len(e.AudioTracks) != 1 || e.AudioTracks[0] != (AudioTrack{AudioCodecTrueHD, "5.1"}) {
+		t.Errorf("expected [{TrueHD 5.1}], got %v", e.AudioTracks)
+	}
+	if len(e.AudioTerm) != 1 || e.AudioTerm[0] != "TrueHD5.1" {
+		t.Errorf("expected raw term \"TrueHD5.1\", got %v", e.AudioTerm)
+	}
+
+	e = Parse("[Group] Title - 01 [2160p HEVC HDR DV][FLAC 5.1].mkv", DefaultOptions)
+	if len(e.VideoHDR) != 2 || e.VideoHDR[0] != HDRFormatHDR || e.VideoHDR[1] != HDRFormatDolbyVision {
+		t.Errorf("expected [HDR DV], got %v", e.VideoHDR)
+	}
+	if len(e.AudioTracks) != 1 || e.AudioTracks[0] != (AudioTrack{AudioCodecFLAC, "5.1"}) {
+		t.Errorf("expected [{FLAC 5.1}], got %v", e.AudioTracks)
+	}
+}
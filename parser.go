@@ -8,11 +8,19 @@ import (
 
 type parser struct {
 	tokenizer *tokenizer
+
+	// Unknown tokens built into an element by buildElement, e.g the words of the title
+	builtTokens map[*token]elementCategory
+
+	// Unknown tokens consumed while resolving the elements, e.g "Multi" and "Subs" in "[Multi Subs]"
+	resolvedTokens map[*token]bool
 }
 
 func newParser(tkz *tokenizer) *parser {
 	psr := parser{
-		tokenizer: tkz,
+		tokenizer:      tkz,
+		builtTokens:    make(map[*token]elementCategory),
+		resolvedTokens: make(map[*token]bool),
 	}
 	return &psr
 }
@@ -23,8 +31,16 @@ func (p *parser) parse() {
 
 	p.searchForShortenedRange()
 
+	p.searchForAirDate()
+
+	if p.tokenizer.sceneNaming {
+		p.searchForSceneTitle()
+	}
+
 	p.searchForKeywords()
 
+	p.searchForAmbiguousKeywords()
+
 	p.searchForIsolatedNumbers()
 
 	if p.tokenizer.options.ParseEpisodeNumber {
@@ -33,21 +49,51 @@ func (p *parser) parse() {
 
 	p.searchForEpisodeNumberAtTheStart() // POST PROCESSING
 
-	p.searchForAnimeTitle()
+	p.searchForEpisodeList()
+
+	if !p.tokenizer.elements.contains(elementCategoryAnimeTitle) {
+		p.searchForAnimeTitle()
+	}
+
+	p.searchForBracketedTitle()
 
 	if p.tokenizer.options.ParseReleaseGroup && !p.tokenizer.elements.contains(elementCategoryReleaseGroup) {
 		p.searchForReleaseGroup()
 	}
 
+	p.searchForReleaseMarkers()
+
 	if p.tokenizer.options.ParseEpisodeTitle && p.tokenizer.elements.contains(elementCategoryEpisodeNumber) {
 		p.searchForEpisodeTitle()
 	}
 
 	p.postProcessing()
+
+	p.resolveResolution()
+
+	p.resolveMediaTerms()
+
+	p.resolveMediaKind()
+
+	p.resolveExtra()
+
+	p.resolveSpecial()
+
+	p.resolveLanguages()
+
+	p.resolveReleaseGroups()
+
+	p.resolveUnknown()
 }
 
 func (p *parser) preProcessing() {
 	for _, tkn := range p.tokenizer.tokens.getListFlag(tokenFlagsUnknown) {
+		// Discard decorations and release announcements, e.g "★", "★10月新番★"
+		if !tkn.Enclosed && isDecorationString(tkn.Content) {
+			tkn.Category = tokenCategoryInvalid
+			continue
+		}
+
 		// Pre-processing
 		// Check if the word is combined with another word like "1+OVA" which happens often
 		parts := strings.Split(tkn.Content, "+")
@@ -103,6 +149,14 @@ func (p *parser) searchForKeywords() {
 			if !p.tokenizer.options.ParseReleaseGroup && category == elementCategoryReleaseGroup {
 				continue
 			}
+			// Leave collaborations to the release group search, e.g "[GroupA & SubsPlease]"
+			if category == elementCategoryReleaseGroup && tkn.Enclosed && !p.isAloneInBrackets(tkn) {
+				continue
+			}
+			// Specials are numbered right away, including unsearchable keywords, e.g "SP 02", "OVA 2"
+			if category == elementCategoryAnimeType && p.checkSpecialKeyword(tkn) {
+				continue
+			}
 			// Skip If the category of the keyword is searchable but the keyword itself isn't
 			if !category.isSearchable() || !kd.options.searchable {
 				continue
@@ -116,7 +170,18 @@ func (p *parser) searchForKeywords() {
 				p.checkAnimeSeasonKeyword(tkn)
 				continue
 			} else if category == elementCategoryAnimePartPrefix { // Part X
-				p.checkAnimePartKeyword(tkn)
+				if !p.checkFilePartKeyword(tkn) { // Part A
+					p.checkAnimePartKeyword(tkn)
+				}
+				continue
+			} else if category == elementCategoryAnimeCourPrefix { // Cour X, Xnd Cour
+				p.checkAnimeCourKeyword(tkn)
+				continue
+			} else if category == elementCategoryDiscPrefix { // Disc X, CD X
+				p.checkDiscKeyword(tkn)
+				continue
+			} else if category == elementCategoryFilePartPrefix { // PT X
+				p.checkFilePartKeyword(tkn)
 				continue
 			} else if category == elementCategoryEpisodePrefix { // Ep X
 				if kd.options.valid {
@@ -134,6 +199,14 @@ func (p *parser) searchForKeywords() {
 				category = elementCategoryFileChecksum
 			} else if !p.tokenizer.elements.contains(elementCategoryVideoResolution) && isResolution(w) {
 				category = elementCategoryVideoResolution
+			} else if isCJKSubtitleTag(w) { // e.g "简繁内封", "简日双语"
+				category = elementCategorySubtitles
+			} else if p.matchFilePartPattern(w, tkn) { // e.g "CD1", "D1", "pt1"
+				continue
+			} else if p.matchSeasonCounterPattern(w, tkn) { // e.g "第2季"
+				continue
+			} else if p.matchCyrillicSeasonPattern(w, tkn) { // e.g "ТВ-2"
+				continue
 			}
 		}
 
@@ -142,7 +215,32 @@ func (p *parser) searchForKeywords() {
 			if kd.empty() || kd.options.identifiable {
 				tkn.Category = tokenCategoryIdentifier
 			}
+			if category == elementCategorySubtitles { // Sub Indo, Sub.FR
+				p.checkSubtitleLanguageKeyword(tkn)
+			}
+			if category == elementCategoryAnimeType { // NCED 2v2, Menu 2
+				p.checkExtraKeyword(tkn)
+			}
+		}
+	}
+}
+
+// Identify keywords that collide with title words, e.g "CR", "NF", "WEB".
+// They are only identified when enclosed or next to other identifiers, e.g "[CR]", "(1080p CR WEB-DL)", "1080p.NF.WEB".
+func (p *parser) searchForAmbiguousKeywords() {
+	for _, tkn := range p.tokenizer.tokens.getListFlag(tokenFlagsUnknown) {
+		kd, found := p.tokenizer.keywordManager.findWithoutCategory(p.tokenizer.keywordManager.normalize(tkn.Content))
+		if !found || kd.options != keywordOptionsAmbiguous {
+			continue
 		}
+		if kd.category.isSingular() && p.tokenizer.elements.contains(kd.category) {
+			continue
+		}
+		if !tkn.Enclosed && !p.tokenizer.tokens.isTokenIsolated(*tkn) && !p.isNextToIdentifier(tkn) {
+			continue
+		}
+		p.tokenizer.elements.insert(kd.category, tkn.Content)
+		tkn.Category = tokenCategoryIdentifier
 	}
 }
 
@@ -160,7 +258,7 @@ func (p *parser) searchForIsolatedNumbers() {
 
 		n, _ := strconv.Atoi(tkn.Content)
 
-		if n >= animeYearMin && n <= animeYearMax {
+		if p.tokenizer.options.Limits.isValidYear(n) {
 			if !p.tokenizer.elements.contains(elementCategoryAnimeYear) {
 				p.tokenizer.elements.insert(elementCategoryAnimeYear, tkn.Content)
 				tkn.Category = tokenCategoryIdentifier
@@ -168,7 +266,7 @@ func (p *parser) searchForIsolatedNumbers() {
 			}
 		}
 
-		if n == 480 || n == 720 || n == 1080 {
+		if isResolutionNumber(n) {
 			if !p.tokenizer.elements.contains(elementCategoryVideoResolution) {
 				p.tokenizer.elements.insert(elementCategoryVideoResolution, tkn.Content)
 				tkn.Category = tokenCategoryIdentifier
@@ -259,7 +357,7 @@ func (p *parser) searchForAnimeTitle() {
 			if !found {
 				break
 			}
-			if isMostlyLatinString(tokenBegin.Content) {
+			if isTitleString(tokenBegin.Content) {
 				if skippedPreviousGroup {
 					break
 				}
@@ -322,12 +420,117 @@ func (p *parser) searchForAnimeTitle() {
 	tokenEnd, _ = p.tokenizer.tokens.findPrevious(*tokenEnd, tokenFlagsValid)
 
 	p.buildElement(elementCategoryAnimeTitle, tokenBegin, tokenEnd, false)
+
+	// Handle bracket-only bilingual titles, e.g "[漆黑的子彈][Black Bullet][11]"
+	if enclosedTitle && isMostlyCJKString(p.tokenizer.elements.get(elementCategoryAnimeTitle)[0]) {
+		p.searchForRomanizedTitle(tokenEnd)
+	}
 }
 
+// Find a romanized title in the bracket group following a native title
+func (p *parser) searchForRomanizedTitle(nativeTitleEnd *token) {
+	closingBracket, found := p.tokenizer.tokens.findNext(*nativeTitleEnd, tokenFlagsBracket)
+	if !found {
+		return
+	}
+	openingBracket, found := p.tokenizer.tokens.findNext(*closingBracket, tokenFlagsNotDelimiter)
+	if !found || openingBracket.Category != tokenCategoryBracket {
+		return
+	}
+	tokenBegin, found := p.tokenizer.tokens.findNext(*openingBracket, tokenFlagsNotDelimiter)
+	if !found || tokenBegin.Category != tokenCategoryUnknown || isNumeric(tokenBegin.Content) || !isMostlyLatinString(tokenBegin.Content) {
+		return
+	}
+	tokenEnd, found := p.tokenizer.tokens.findNext(*tokenBegin, tokenFlagsBracket|tokenFlagsIdentifier)
+	if !found || tokenEnd.Category != tokenCategoryBracket {
+		return
+	}
+	tokenEnd, _ = p.tokenizer.tokens.findPrevious(*tokenEnd, tokenFlagsValid)
+
+	p.tokenizer.elements.insert(elementCategoryAnimeTitleNative, p.tokenizer.elements.get(elementCategoryAnimeTitle)[0])
+	p.buildElement(elementCategoryAnimeTitle, tokenBegin, tokenEnd, false)
+}
+
+// Find a native title in Japanese quotation brackets following the anime title, e.g "Sousou no Frieren 「葬送のフリーレン」"
+func (p *parser) searchForBracketedTitle() {
+	if !p.tokenizer.elements.contains(elementCategoryAnimeTitle) || p.tokenizer.elements.contains(elementCategoryAnimeTitleNative) {
+		return
+	}
+	for _, tkn := range p.tokenizer.tokens.getListFlag(tokenFlagsBracket) {
+		if tkn.Content != "\u300C" && tkn.Content != "\u300E" {
+			continue
+		}
+		// The brackets must directly follow the anime title
+		prevToken, found := p.tokenizer.tokens.findPrevious(*tkn, tokenFlagsNotDelimiter)
+		if !found || prevToken.Category != tokenCategoryIdentifier ||
+			!strings.HasSuffix(p.tokenizer.elements.AnimeTitle, prevToken.Content) {
+			continue
+		}
+		tokenBegin, found := p.tokenizer.tokens.findNext(*tkn, tokenFlagsNotDelimiter)
+		if !found || tokenBegin.Category != tokenCategoryUnknown || !isMostlyCJKString(tokenBegin.Content) {
+			continue
+		}
+		tokenEnd, found := p.tokenizer.tokens.findNext(*tokenBegin, tokenFlagsBracket|tokenFlagsIdentifier)
+		if !found || tokenEnd.Category != tokenCategoryBracket {
+			continue
+		}
+		tokenEnd, _ = p.tokenizer.tokens.findPrevious(*tokenEnd, tokenFlagsValid)
+		p.buildElement(elementCategoryAnimeTitleNative, tokenBegin, tokenEnd, false)
+		return
+	}
+}
+
+// A bracket group that could be the release group, e.g "[Group]"
+type releaseGroupCandidate struct {
+	begin *token
+	end   *token
+	score int
+}
+
+// Release group candidate scores
+const (
+	releaseGroupScoreMiddle   = 1 // e.g "Title [Group] - 05"
+	releaseGroupScoreTrailing = 2 // e.g "Title - 05 (1080p) [Group]"
+	releaseGroupScoreLeading  = 3 // e.g "[Group] Title - 05"
+	releaseGroupScoreKnown    = 5 // e.g "[Erai-raws]", see Options.ReleaseGroupAliases
+)
+
+// Find the release group among the bracket groups made of unknown tokens.
+// Leading and trailing brackets are preferred, and names of known release groups are preferred over the others.
+// When no bracket group is found, a trailing dash group is used, e.g "Title - 05 -Group".
 func (p *parser) searchForReleaseGroup() {
+	var best *releaseGroupCandidate
+	for _, candidate := range p.findReleaseGroupCandidates() {
+		list := p.tokenizer.tokens.getList(tokenFlagsValid, candidate.begin, candidate.end)
+
+		// If "Season" "Part" "EP" is found inside, process it accordingly
+		if p.checkReleaseGroupCandidateKeywords(candidate, list) {
+			continue
+		}
+		if p.isKeywordList(list) { // e.g "[Multi Subs]"
+			continue
+		}
+
+		if best == nil || candidate.score > best.score {
+			best = candidate
+		}
+	}
+
+	if best != nil {
+		p.buildElement(elementCategoryReleaseGroup, best.begin, best.end, true)
+		return
+	}
+
+	p.searchForTrailingDashReleaseGroup()
+}
+
+// Find the bracket groups that are only made of unknown tokens, along with their score
+func (p *parser) findReleaseGroupCandidates() []*releaseGroupCandidate {
+	var candidates []*releaseGroupCandidate
+	firstToken, _ := p.tokenizer.tokens.find(tokenFlagsNotDelimiter)
+
 	tokenEnd := &token{}
 	tokenBegin := &token{}
-	previousToken := &token{}
 	for {
 		if !tokenEnd.empty() {
 			tokenBegin, _ = p.tokenizer.tokens.findNext(*tokenEnd, tokenFlagsEnclosed|tokenFlagsUnknown)
@@ -335,51 +538,125 @@ func (p *parser) searchForReleaseGroup() {
 			tokenBegin, _ = p.tokenizer.tokens.find(tokenFlagsEnclosed | tokenFlagsUnknown)
 		}
 		if tokenBegin.empty() {
-			return
+			return candidates
 		}
 		tokenEnd, _ = p.tokenizer.tokens.findNext(*tokenBegin, tokenFlagsBracket|tokenFlagsIdentifier)
 		if tokenEnd.empty() {
-			return
+			return candidates
 		}
 		if tokenEnd.Category != tokenCategoryBracket {
 			continue
 		}
-		previousToken, _ = p.tokenizer.tokens.findPrevious(*tokenBegin, tokenFlagsNotDelimiter)
-		if !previousToken.empty() && previousToken.Category != tokenCategoryBracket {
+		openingBracket, _ := p.tokenizer.tokens.findPrevious(*tokenBegin, tokenFlagsNotDelimiter)
+		if !openingBracket.empty() && openingBracket.Category != tokenCategoryBracket {
 			continue
 		}
 
-		tokenEnd, _ = p.tokenizer.tokens.findPrevious(*tokenEnd, tokenFlagsValid)
+		candidate := &releaseGroupCandidate{score: releaseGroupScoreMiddle}
+		if !openingBracket.empty() && openingBracket.UUID == firstToken.UUID {
+			candidate.score = releaseGroupScoreLeading
+		} else if next, found := p.tokenizer.tokens.findNext(*tokenEnd, tokenFlagsNotDelimiter); !found || next.empty() {
+			candidate.score = releaseGroupScoreTrailing
+		}
 
-		list := p.tokenizer.tokens.getList(tokenFlagsValid, tokenBegin, tokenEnd)
-
-		for _, tk := range list {
-			// If "Season" "Part" "EP" is found inside, process it accordingly
-			_, foundS := p.tokenizer.keywordManager.find(p.tokenizer.keywordManager.normalize(tk.Content), elementCategoryAnimeSeasonPrefix)
-			_, foundP := p.tokenizer.keywordManager.find(p.tokenizer.keywordManager.normalize(tk.Content), elementCategoryAnimePartPrefix)
-			_, foundE := p.tokenizer.keywordManager.find(p.tokenizer.keywordManager.normalize(tk.Content), elementCategoryEpisodePrefix)
-			_, foundAT := p.tokenizer.keywordManager.find(p.tokenizer.keywordManager.normalize(tk.Content), elementCategoryAnimeType)
-			if foundS || foundP || foundE || foundAT {
-				// Remove brackets
-				tokenBegin.Category = tokenCategoryInvalid
-				tokenEnd.Category = tokenCategoryInvalid
-				if foundS {
-					p.checkAnimeSeasonKeyword(tk)
-				} else if foundP {
-					p.checkAnimePartKeyword(tk)
-				} else if foundE {
-					p.searchForEpisodeNumber()
-				} else if foundAT {
-					p.tokenizer.elements.insert(elementCategoryAnimeType, tk.Content)
-					tk.Category = tokenCategoryIdentifier
-				}
-				return
+		candidate.begin = tokenBegin
+		candidate.end, _ = p.tokenizer.tokens.findPrevious(*tokenEnd, tokenFlagsValid)
+
+		if p.isKnownReleaseGroup(p.tokenizer.tokens.getList(tokenFlagsValid, candidate.begin, candidate.end)) {
+			candidate.score += releaseGroupScoreKnown
+		}
+		candidates = append(candidates, candidate)
+	}
+}
+
+// Process the season, part, episode and anime type keywords of a release group candidate, e.g "[Season 2]".
+// Returns true if the candidate is not a release group.
+func (p *parser) checkReleaseGroupCandidateKeywords(candidate *releaseGroupCandidate, list tokens) bool {
+	km := p.tokenizer.keywordManager
+	for _, tk := range list {
+		_, foundS := km.find(km.normalize(tk.Content), elementCategoryAnimeSeasonPrefix)
+		_, foundP := km.find(km.normalize(tk.Content), elementCategoryAnimePartPrefix)
+		_, foundE := km.find(km.normalize(tk.Content), elementCategoryEpisodePrefix)
+		_, foundAT := km.find(km.normalize(tk.Content), elementCategoryAnimeType)
+		if foundS || foundP || foundE || foundAT {
+			// Remove brackets
+			openingBracket, _ := p.tokenizer.tokens.findPrevious(*candidate.begin, tokenFlagsBracket)
+			closingBracket, _ := p.tokenizer.tokens.findNext(*candidate.end, tokenFlagsBracket)
+			openingBracket.Category = tokenCategoryInvalid
+			closingBracket.Category = tokenCategoryInvalid
+			if foundS {
+				p.checkAnimeSeasonKeyword(tk)
+			} else if foundP {
+				p.checkAnimePartKeyword(tk)
+			} else if foundE {
+				p.searchForEpisodeNumber()
+			} else if foundAT {
+				p.tokenizer.elements.insert(elementCategoryAnimeType, tk.Content)
+				tk.Category = tokenCategoryIdentifier
 			}
+			return true
 		}
+	}
+	return false
+}
 
-		p.buildElement(elementCategoryReleaseGroup, tokenBegin, tokenEnd, true)
+// Returns true if all the words of the list are keywords, e.g "Multi Subs"
+func (p *parser) isKeywordList(list tokens) bool {
+	km := p.tokenizer.keywordManager
+	words := 0
+	for _, tk := range list {
+		if tk.Category == tokenCategoryDelimiter {
+			continue
+		}
+		words++
+		w := km.normalize(tk.Content)
+		_, isLanguage := languageTags[w]
+		if _, found := km.findWithoutCategory(w); !found && !isLanguage && !isSubtitleWord(w) && !isDubWord(w) {
+			return false
+		}
+	}
+	return words > 0
+}
+
+// Returns true if the list is the name of a known release group, or a collaboration including one
+func (p *parser) isKnownReleaseGroup(list tokens) bool {
+	name := ""
+	for _, tk := range list {
+		name += tk.Content
+	}
+	for _, group := range splitReleaseGroup(name, p.isKnownReleaseGroupName) {
+		if p.isKnownReleaseGroupName(group) {
+			return true
+		}
+	}
+	return false
+}
+
+// Returns true if the name is a release group keyword, an alias or a canonical name, e.g "Erai-raws"
+func (p *parser) isKnownReleaseGroupName(name string) bool {
+	km := p.tokenizer.keywordManager
+	if _, found := km.find(km.normalize(name), elementCategoryReleaseGroup); found {
+		return true
+	}
+	return p.tokenizer.options.ReleaseGroupAliases.contains(km, name)
+}
+
+// e.g "Title - 05 -Group"
+func (p *parser) searchForTrailingDashReleaseGroup() {
+	tkns := p.tokenizer.tokens.getListFlag(tokenFlagsNotDelimiter)
+	if len(tkns) < 2 {
 		return
 	}
+	tkn := tkns[len(tkns)-1]
+	if tkn.Enclosed || tkn.Category != tokenCategoryUnknown || len(tkn.Content) < 3 || tkn.Content[0] != '-' {
+		return
+	}
+	group := tkn.Content[1:]
+	if !isMostlyLatinString(group) || isNumeric(group) {
+		return
+	}
+	p.tokenizer.elements.insert(elementCategoryReleaseGroup, group)
+	tkn.Category = tokenCategoryIdentifier
 }
 
 func (p *parser) searchForEpisodeTitle() {
@@ -394,6 +671,11 @@ func (p *parser) searchForEpisodeTitle() {
 		if tokenBegin.empty() {
 			return
 		}
+		// Subtitles are never part of the episode title, e.g "GB" in "第五集 GB 720P"
+		if p.isSubtitlesToken(tokenBegin) {
+			tokenEnd = tokenBegin
+			continue
+		}
 		tokenEnd, _ = p.tokenizer.tokens.findNext(*tokenBegin, tokenFlagsBracket|tokenFlagsIdentifier)
 		if tokenEnd.empty() {
 			tokenEnd, _ = p.tokenizer.tokens.get(len(*p.tokenizer.tokens) - 1)
@@ -406,11 +688,79 @@ func (p *parser) searchForEpisodeTitle() {
 		if !tokenEnd.empty() && tokenEnd.Category == tokenCategoryBracket {
 			tokenEnd, _ = p.tokenizer.tokens.findPrevious(*tokenEnd, tokenFlagsValid)
 		}
+		for tokenEnd != tokenBegin && (tokenEnd.Category == tokenCategoryDelimiter || p.isSubtitlesToken(tokenEnd)) {
+			tokenEnd, _ = p.tokenizer.tokens.findPrevious(*tokenEnd, tokenFlagsValid)
+		}
 		p.buildElement(elementCategoryEpisodeTitle, tokenBegin, tokenEnd, false)
+		p.splitEpisodeTitles()
 		return
 	}
 }
 
+// Returns true if the token is a keyword already inserted into Subtitles, e.g "GB"
+func (p *parser) isSubtitlesToken(tkn *token) bool {
+	km := p.tokenizer.keywordManager
+	kd, found := km.findWithoutCategory(km.normalize(tkn.Content))
+	return found && kd.category == elementCategorySubtitles && checkInList(p.tokenizer.elements.Subtitles, tkn.Content)
+}
+
+// e.g " / 06 - " in "Part One / 06 - Part Two"
+var episodeTitleNumberSeparatorRe = regexp.MustCompile(`\s*/\s*(\d{1,4})\s*-\s*`)
+
+// e.g " & " in "Title A & Title B"
+var episodeTitleSeparatorRe = regexp.MustCompile(`\s+[&/]\s+`)
+
+// Split the episode title of multi-episode files into the title of each episode, in the order of the episode numbers,
+// e.g "01-02 - Title A & Title B", "05 - Part One / 06 - Part Two"
+func (p *parser) splitEpisodeTitles() {
+	elems := p.tokenizer.elements
+	episodeTitle := elems.EpisodeTitle
+
+	// The following episode numbers are part of the title, e.g "/ 06 -"
+	if matches := episodeTitleNumberSeparatorRe.FindAllStringSubmatchIndex(episodeTitle, -1); matches != nil {
+		last := stringToInt(elems.EpisodeNumber[len(elems.EpisodeNumber)-1])
+		var titles, numbers []string
+		begin := 0
+		for _, m := range matches {
+			number := episodeTitle[m[2]:m[3]]
+			if stringToInt(number) <= last || !p.isValidEpisodeNumber(number) {
+				return
+			}
+			last = stringToInt(number)
+			titles = append(titles, episodeTitle[begin:m[0]])
+			numbers = append(numbers, number)
+			begin = m[1]
+		}
+		titles = append(titles, episodeTitle[begin:])
+		if len(elems.EpisodeNumber) != 1 || checkInList(titles, "") {
+			return
+		}
+		elems.EpisodeRanges = append(elems.EpisodeRanges, EpisodeRange{From: elems.EpisodeNumber[0], To: elems.EpisodeNumber[0]})
+		for _, number := range numbers {
+			elems.insert(elementCategoryEpisodeNumber, number)
+			elems.EpisodeRanges = append(elems.EpisodeRanges, EpisodeRange{From: number, To: number})
+		}
+		elems.EpisodeTitles = titles
+		return
+	}
+
+	count := len(elems.EpisodeNumber)
+	if elems.EpisodeRanges != nil {
+		count = 0
+		for _, r := range elems.EpisodeRanges {
+			count += stringToInt(r.To) - stringToInt(r.From) + 1
+		}
+	}
+	if count < 2 {
+		return
+	}
+	titles := episodeTitleSeparatorRe.Split(episodeTitle, -1)
+	if len(titles) != count || checkInList(titles, "") {
+		return
+	}
+	elems.EpisodeTitles = titles
+}
+
 func (p *parser) postProcessing() {
 	// handle cases where parsed episode title might contain an episode number
 	if p.tokenizer.elements.contains(elementCategoryEpisodeTitle) {
@@ -507,4 +857,260 @@ func (p *parser) postProcessing() {
 
 	}
 
+	// handle alternative titles, e.g "葬送的芙莉莲 / Sousou no Frieren", "Title A | Title B"
+	if p.tokenizer.elements.contains(elementCategoryAnimeTitle) {
+		p.splitAlternativeTitles()
+	}
+}
+
+// Build the structured resolution from the raw resolution or the resolution video terms
+func (p *parser) resolveResolution() {
+	if p.tokenizer.elements.contains(elementCategoryVideoResolution) {
+		resolution := parseResolution(p.tokenizer.elements.get(elementCategoryVideoResolution)[0])
+		if resolution != nil {
+			p.tokenizer.elements.Resolution = resolution
+			// Repeated resolutions are the same element, e.g "[1080pHEVC][BD1080p]"
+			for _, tkn := range p.tokenizer.tokens.getListFlag(tokenFlagsUnknown) {
+				if other := parseResolution(tkn.Content); other != nil && other.Label == resolution.Label {
+					p.resolvedTokens[tkn] = true
+				}
+			}
+			return
+		}
+	}
+
+	for _, term := range p.tokenizer.elements.get(elementCategoryVideoTerm) {
+		if _, found := resolutionTerms[p.tokenizer.keywordManager.normalize(term)]; found {
+			p.tokenizer.elements.Resolution = parseResolution(term)
+			return
+		}
+	}
+}
+
+// Build the canonical video codec, bit depth, HDR formats, audio tracks and source type from the raw terms
+func (p *parser) resolveMediaTerms() {
+	km := p.tokenizer.keywordManager
+	elems := p.tokenizer.elements
+
+	for _, term := range elems.get(elementCategoryVideoTerm) {
+		w := km.normalize(term)
+		if codec, found := videoCodecs[w]; found && elems.VideoCodec == "" {
+			elems.VideoCodec = codec
+		} else if depth, found := videoBitDepths[w]; found && elems.VideoBitDepth == 0 {
+			elems.VideoBitDepth = depth
+		} else if format, found := hdrFormats[w]; found {
+			elems.VideoHDR = appendHDRFormat(elems.VideoHDR, format)
+		}
+	}
+
+	// Channel layouts can be written before or after their codec, e.g "[2.0ch AAC]", "[AAC 2.0]"
+	pendingChannels := ""
+	for _, term := range elems.get(elementCategoryAudioTerm) {
+		codec, channels := splitAudioTerm(km.normalize(term))
+		if codec == "" {
+			if channels == "" {
+				continue
+			}
+			last := len(elems.AudioTracks) - 1
+			if last >= 0 && elems.AudioTracks[last].Channels == "" {
+				elems.AudioTracks[last].Channels = channels
+			} else {
+				pendingChannels = channels
+			}
+			continue
+		}
+		if channels == "" {
+			channels = pendingChannels
+		}
+		pendingChannels = ""
+		elems.AudioTracks = append(elems.AudioTracks, AudioTrack{Codec: codec, Channels: channels})
+	}
+
+	for _, term := range elems.get(elementCategorySource) {
+		if sourceType, found := sourceTypes[km.normalize(term)]; found {
+			elems.SourceType = sourceType
+			break
+		}
+	}
+}
+
+// Returns the media kinds of the anime type keywords found outside the titles,
+// e.g "Opening" in "Summer Pool Opening - 03" is a word of the title.
+func (p *parser) identifiedMediaKinds() map[MediaKind]bool {
+	km := p.tokenizer.keywordManager
+	elems := p.tokenizer.elements
+
+	found := map[MediaKind]bool{}
+	for _, term := range append(elems.get(elementCategoryAnimeType), elems.get(elementCategoryOther)...) {
+		kind, ok := mediaKinds[km.normalize(term)]
+		if !ok || p.isTitleWord(term) {
+			continue
+		}
+		found[kind] = true
+	}
+	return found
+}
+
+// Returns true if the word was only found in the anime title or the episode title
+func (p *parser) isTitleWord(w string) bool {
+	inTitle := false
+	for _, tkn := range *p.tokenizer.tokens {
+		if strings.Trim(tkn.Content, " -") != w {
+			continue
+		}
+		cat, built := p.builtTokens[tkn]
+		if !built || (cat != elementCategoryAnimeTitle && cat != elementCategoryEpisodeTitle) {
+			return false
+		}
+		inTitle = true
+	}
+	return inTitle
+}
+
+// Build the media kind from the anime types and other terms, falling back on the numbers found in the filename.
+// e.g "S00E04" is a special, and "Title (2019)" without an episode number is a movie.
+func (p *parser) resolveMediaKind() {
+	elems := p.tokenizer.elements
+
+	found := p.identifiedMediaKinds()
+	for _, kind := range mediaKindPrecedence {
+		if found[kind] {
+			elems.MediaKind = kind
+			return
+		}
+	}
+
+	for _, season := range elems.AnimeSeason {
+		if isNumeric(season) && stringToInt(season) == 0 {
+			elems.MediaKind = MediaKindSpecial
+			return
+		}
+	}
+	if elems.contains(elementCategoryEpisodeNumber) {
+		elems.MediaKind = MediaKindEpisode
+	} else if elems.contains(elementCategoryAnimeYear) && !elems.contains(elementCategoryAnimeSeason) &&
+		!elems.contains(elementCategoryVolumeNumber) && !p.isBatchRelease() {
+		elems.MediaKind = MediaKindMovie
+	}
+}
+
+// Returns true if the release information marks a batch, e.g "[Batch]", "Complete"
+func (p *parser) isBatchRelease() bool {
+	km := p.tokenizer.keywordManager
+	for _, info := range p.tokenizer.elements.ReleaseInformation {
+		if w := km.normalize(info); w == "BATCH" || w == "COMPLETE" {
+			return true
+		}
+	}
+	return false
+}
+
+// Returns true if the normalized word was identified as a language keyword
+func (p *parser) containsLanguage(w string) bool {
+	for _, language := range p.tokenizer.elements.Language {
+		if p.tokenizer.keywordManager.normalize(language) == w {
+			return true
+		}
+	}
+	return false
+}
+
+// Build the audio and subtitle languages from the language tokens and the tokens surrounding them,
+// e.g "[ENG SUB]", "[ENG DUB]", "VOSTFR", "Sub{Fr}"
+func (p *parser) resolveLanguages() {
+	km := p.tokenizer.keywordManager
+	elems := p.tokenizer.elements
+
+	type languageWord struct {
+		tkn      *token
+		content  string
+		enclosed bool
+		group    int // Index of the bracket group the word is in
+	}
+
+	var words []languageWord
+	group := 0
+	for _, tkn := range *p.tokenizer.tokens {
+		switch tkn.Category {
+		case tokenCategoryBracket:
+			group++
+			continue
+		case tokenCategoryDelimiter, tokenCategoryInvalid:
+			continue
+		}
+		words = append(words, languageWord{tkn, km.normalize(tkn.Content), tkn.Enclosed, group})
+	}
+
+	for i, w := range words {
+		next := languageWord{group: -1}
+		if i+1 < len(words) {
+			next = words[i+1]
+		}
+
+		// Handle "简繁内封", "简日双语"
+		if isCJKSubtitleTag(w.content) {
+			elems.SubtitleLanguages = appendLanguages(elems.SubtitleLanguages, cjkSubtitleLanguages(w.content)...)
+			p.resolvedTokens[w.tkn] = true
+			continue
+		}
+
+		// Handle "Sub.FR", "Sub{Fr}", "Sub(JP-EN-FR)"
+		if isSubtitleWord(w.content) {
+			if codes, found := subtitleLanguageList(next.content); found {
+				elems.SubtitleLanguages = appendLanguages(elems.SubtitleLanguages, codes...)
+				p.resolvedTokens[w.tkn] = true
+				p.resolvedTokens[next.tkn] = true
+			}
+			continue
+		}
+
+		tag, kind, found := splitLanguageKind(w.content)
+		if !found {
+			tag, found = languageTags[w.content]
+			if !found {
+				continue
+			}
+			// e.g "Tokyo ESP", "Bokura ga Ita", "The Italian Job", "Multiple Personality Girl"
+			kd, isKeyword := km.findWithoutCategory(w.content)
+			if !w.enclosed && (!isKeyword || !kd.options.identifiable) {
+				continue
+			}
+			if !w.enclosed && kd.options == keywordOptionsAmbiguous && !p.containsLanguage(w.content) {
+				continue
+			}
+			if next.group == w.group {
+				kind = next.content
+				if isSubtitleWord(kind) || isDubWord(kind) {
+					p.resolvedTokens[next.tkn] = true
+				}
+			}
+		}
+		p.resolvedTokens[w.tkn] = true
+
+		elems.AudioLanguages = appendLanguages(elems.AudioLanguages, tag.impliedAudio...)
+		elems.SubtitleLanguages = appendLanguages(elems.SubtitleLanguages, tag.impliedSubtitles...)
+		if tag.code == "" {
+			continue
+		}
+
+		if isSubtitleWord(kind) || (!isDubWord(kind) && !tag.audio) {
+			elems.SubtitleLanguages = appendLanguages(elems.SubtitleLanguages, tag.code)
+		} else {
+			elems.AudioLanguages = appendLanguages(elems.AudioLanguages, tag.code)
+		}
+	}
+}
+
+// Split collaborations into their release groups and resolve their canonical names
+func (p *parser) resolveReleaseGroups() {
+	elems := p.tokenizer.elements
+	if elems.ReleaseGroup == "" {
+		return
+	}
+	for _, group := range splitReleaseGroup(elems.ReleaseGroup, p.isKnownReleaseGroupName) {
+		group = p.tokenizer.options.ReleaseGroupAliases.canonical(p.tokenizer.keywordManager, group)
+		if !checkInList(elems.ReleaseGroups, group) {
+			elems.ReleaseGroups = append(elems.ReleaseGroups, group)
+		}
+	}
 }
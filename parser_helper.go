@@ -5,6 +5,7 @@ import (
 	"strconv"
 	"strings"
 	"unicode"
+	"unicode/utf8"
 )
 
 const dashes = "-\u2010\u2011\u2012\u2013\u2014\u2015"
@@ -15,11 +16,21 @@ func (p *parser) checkAnimeSeasonKeyword(tkn *token) bool {
 	// Handle "4th Season", etc...
 	prevToken, found := p.tokenizer.tokens.findPrevious(*tkn, tokenFlagsNotDelimiter)
 	if found {
-		num := getNumberFromOrdinal(prevToken.Content)
+		// Handle "The Final Season", which stays in the title
+		if p.tokenizer.keywordManager.normalize(prevToken.Content) == "FINAL" {
+			p.tokenizer.elements.IsFinalSeason = true
+		}
+		num := getNumberFromOrdinal(p.tokenizer.keywordManager.normalize(prevToken.Content))
 		if num != 0 {
 			p.setAnimeSeason(prevToken, tkn, strconv.Itoa(num))
 			return true
 		}
+		// Handle "2 сезон", etc...
+		if isNumeric(prevToken.Content) && prevToken.Category == tokenCategoryUnknown &&
+			isTrailingCountWord(p.tokenizer.keywordManager.normalize(tkn.Content)) {
+			p.setAnimeSeason(prevToken, tkn, prevToken.Content)
+			return true
+		}
 	}
 
 	nextToken, found := p.tokenizer.tokens.findNext(*tkn, tokenFlagsNotDelimiter)
@@ -96,6 +107,14 @@ func (p *parser) checkAnimeSeasonKeyword(tkn *token) bool {
 
 		return true
 	}
+
+	// Handle "Season Two", "Season II", etc...
+	if found && p.isNumberWordOfTitle(tkn, nextToken) {
+		if num := getNumberFromWord(p.tokenizer.keywordManager.normalize(nextToken.Content)); num != 0 {
+			p.setAnimeSeason(tkn, nextToken, strconv.Itoa(num))
+			return true
+		}
+	}
 	return false
 }
 
@@ -114,7 +133,7 @@ func (p *parser) setAnimeSeason(first, second *token, content string) {
 func (p *parser) checkAnimePartKeyword(tkn *token) bool {
 	prevToken, found := p.tokenizer.tokens.findPrevious(*tkn, tokenFlagsNotDelimiter)
 	if found {
-		num := getNumberFromOrdinal(prevToken.Content)
+		num := getNumberFromOrdinal(p.tokenizer.keywordManager.normalize(prevToken.Content))
 		if num != 0 {
 			p.setAnimePart(prevToken, tkn, strconv.Itoa(num))
 			return true
@@ -164,6 +183,14 @@ func (p *parser) checkAnimePartKeyword(tkn *token) bool {
 
 		return true
 	}
+
+	// Handle "Part Two", "Part II", etc...
+	if found && p.isNumberWordOfTitle(tkn, nextToken) {
+		if num := getNumberFromWord(p.tokenizer.keywordManager.normalize(nextToken.Content)); num != 0 {
+			p.setAnimePart(tkn, nextToken, strconv.Itoa(num))
+			return true
+		}
+	}
 	return false
 }
 
@@ -177,6 +204,100 @@ func (p *parser) setAnimePart(first, second *token, content string) {
 	secondTkn.Category = tokenCategoryIdentifier
 }
 
+// Handle "2nd Cour", "Cour 2", "Cour II", etc...
+func (p *parser) checkAnimeCourKeyword(tkn *token) bool {
+	prevToken, found := p.tokenizer.tokens.findPrevious(*tkn, tokenFlagsNotDelimiter)
+	if found {
+		num := getNumberFromOrdinal(p.tokenizer.keywordManager.normalize(prevToken.Content))
+		if num != 0 {
+			p.setAnimeCour(prevToken, tkn, strconv.Itoa(num))
+			return true
+		}
+	}
+
+	nextToken, found := p.tokenizer.tokens.findNext(*tkn, tokenFlagsNotDelimiter)
+	if !found || nextToken.Category != tokenCategoryUnknown {
+		return false
+	}
+	if isNumeric(nextToken.Content) {
+		p.setAnimeCour(tkn, nextToken, nextToken.Content)
+		return true
+	}
+	if num := getNumberFromWord(p.tokenizer.keywordManager.normalize(nextToken.Content)); num != 0 && p.isNumberWordOfTitle(tkn, nextToken) {
+		p.setAnimeCour(tkn, nextToken, strconv.Itoa(num))
+		return true
+	}
+	return false
+}
+
+// Returns true if the number word following the keyword ends the anime title, e.g "Season II - 03", "Part Two [1080p]".
+// Number words found after the episode number are left in the episode title, e.g "S01E01 - Enrollment Part I",
+// but numbers of the anime title are not episode numbers, e.g "Mob Psycho 100 Season II - 03".
+func (p *parser) isNumberWordOfTitle(keyword, number *token) bool {
+	if number.Category != tokenCategoryUnknown {
+		return false
+	}
+	nextToken, found := p.tokenizer.tokens.findNext(*number, tokenFlagsNotDelimiter)
+	if !found || (nextToken.Category != tokenCategoryBracket && !isDashCharacter(nextToken.Content) && !isNumeric(nextToken.Content)) {
+		return false
+	}
+	for _, tkn := range *p.tokenizer.tokens {
+		if tkn == keyword {
+			break
+		}
+		if !tkn.Enclosed && tkn.Category != tokenCategoryDelimiter && p.isEpisodeNumberToken(tkn) {
+			return false
+		}
+	}
+	return true
+}
+
+// e.g "S01E01", "E05", "EP05v2"
+var episodeNumberTokenRe = regexp.MustCompile(`(?i)^(?:[ST]?\d{1,2}[._-]?)?EP?\d{1,4}(?:[vV]\d{1,2})?$`)
+
+// e.g "05", "05v2", "01-02"
+var dashedEpisodeNumberTokenRe = regexp.MustCompile(`^\d{1,4}(?:[vV]\d{1,2})?(?:[-~&]\d{1,4})?$`)
+
+// Returns true if the token reads as an episode number before the episode numbers are searched,
+// e.g "S01E01" or "05" in "Title - 05"
+func (p *parser) isEpisodeNumberToken(tkn *token) bool {
+	content := strings.Trim(tkn.Content, " -")
+	if episodeNumberTokenRe.MatchString(content) {
+		return true
+	}
+	if !dashedEpisodeNumberTokenRe.MatchString(content) {
+		return false
+	}
+	if strings.HasPrefix(strings.TrimSpace(tkn.Content), "-") {
+		return true
+	}
+	previousToken, found := p.tokenizer.tokens.findPrevious(*tkn, tokenFlagsNotDelimiter)
+	return found && isDashCharacter(previousToken.Content)
+}
+
+func (p *parser) setAnimeCour(first, second *token, content string) {
+	p.tokenizer.elements.insert(elementCategoryAnimeCour, content)
+	first.Category = tokenCategoryIdentifier
+	second.Category = tokenCategoryIdentifier
+}
+
+// Identify the language codes following a subtitle keyword, e.g "Indo" in "Sub Indo", "FR" in "Sub.FR"
+func (p *parser) checkSubtitleLanguageKeyword(tkn *token) bool {
+	if !isSubtitleWord(p.tokenizer.keywordManager.normalize(tkn.Content)) {
+		return false
+	}
+	nextToken, found := p.tokenizer.tokens.findNext(*tkn, tokenFlagsNotDelimiter)
+	if !found || nextToken.Category != tokenCategoryUnknown {
+		return false
+	}
+	if _, found := subtitleLanguageList(p.tokenizer.keywordManager.normalize(nextToken.Content)); !found {
+		return false
+	}
+	p.tokenizer.elements.insert(elementCategoryLanguage, nextToken.Content)
+	nextToken.Category = tokenCategoryIdentifier
+	return true
+}
+
 func (p *parser) buildElement(cat elementCategory, beginToken, endToken *token, keepDelimiters bool) {
 	element := ""
 
@@ -185,6 +306,7 @@ func (p *parser) buildElement(cat elementCategory, beginToken, endToken *token,
 		if tkn.Category == tokenCategoryUnknown {
 			element += tkn.Content
 			tkn.Category = tokenCategoryIdentifier
+			p.builtTokens[tkn] = cat
 		} else if tkn.Category == tokenCategoryBracket {
 			element += tkn.Content
 		} else if tkn.Category == tokenCategoryDelimiter {
@@ -192,7 +314,7 @@ func (p *parser) buildElement(cat elementCategory, beginToken, endToken *token,
 			if keepDelimiters {
 				element += delimiter
 			} else if tkn != beginToken && tkn != endToken {
-				if delimiter == "," || delimiter == "&" {
+				if delimiter == "," || delimiter == "&" || delimiter == "|" {
 					element += delimiter
 				} else {
 					element += " "
@@ -210,6 +332,26 @@ func (p *parser) buildElement(cat elementCategory, beginToken, endToken *token,
 	}
 }
 
+// Returns true if the previous or next non-delimiter token is an identifier, e.g "CR" in "1080p.CR.WEB-DL"
+func (p *parser) isNextToIdentifier(tkn *token) bool {
+	prevToken, found := p.tokenizer.tokens.findPrevious(*tkn, tokenFlagsNotDelimiter)
+	if found && prevToken.Category == tokenCategoryIdentifier {
+		return true
+	}
+	nextToken, found := p.tokenizer.tokens.findNext(*tkn, tokenFlagsNotDelimiter)
+	return found && nextToken.Category == tokenCategoryIdentifier
+}
+
+// Returns true if the token is the only word between its brackets, e.g "[SubsPlease]"
+func (p *parser) isAloneInBrackets(tkn *token) bool {
+	prevToken, found := p.tokenizer.tokens.findPrevious(*tkn, tokenFlagsNotDelimiter)
+	if !found || prevToken.Category != tokenCategoryBracket {
+		return false
+	}
+	nextToken, found := p.tokenizer.tokens.findNext(*tkn, tokenFlagsNotDelimiter)
+	return found && nextToken.Category == tokenCategoryBracket
+}
+
 func findNonNumberInString(str string) int {
 	for _, r := range str {
 		if !unicode.IsDigit(r) {
@@ -247,6 +389,66 @@ func isLatinRune(r rune) bool {
 	return unicode.In(r, unicode.Latin)
 }
 
+// Chinese, Japanese and Korean characters
+func isCJKRune(r rune) bool {
+	return unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana, unicode.Hangul)
+}
+
+func isCyrillicRune(r rune) bool {
+	return unicode.Is(unicode.Cyrillic, r)
+}
+
+// Returns true if the string is mostly made of characters that can be part of a title
+func isTitleString(str string) bool {
+	if len(str) <= 0 {
+		return false
+	}
+	titleLength := 0
+	otherLength := 0
+	for _, r := range str {
+		if isLatinRune(r) || isCJKRune(r) || isCyrillicRune(r) {
+			titleLength++
+		} else {
+			otherLength++
+		}
+	}
+	return titleLength > otherLength
+}
+
+func isMostlyCJKString(str string) bool {
+	if len(str) <= 0 {
+		return false
+	}
+	cjkLength := 0
+	otherLength := 0
+	for _, r := range str {
+		if isCJKRune(r) {
+			cjkLength++
+		} else if !unicode.IsSpace(r) && !unicode.IsPunct(r) {
+			otherLength++
+		}
+	}
+	return cjkLength > otherLength
+}
+
+// Returns true for decorations and release announcements, e.g "★", "★10月新番★", "★10月新番"
+func isDecorationString(str string) bool {
+	const decorations = "★☆"
+	if strings.Trim(str, decorations) == "" {
+		return true
+	}
+	first, size := utf8.DecodeRuneInString(str)
+	if !strings.ContainsRune(decorations, first) {
+		return false
+	}
+	last, _ := utf8.DecodeLastRuneInString(str)
+	if strings.ContainsRune(decorations, last) {
+		return true
+	}
+	// A leading decoration marks a tag like "★10月新番", unless it's part of a romanized title.
+	return !isMostlyLatinString(str[size:])
+}
+
 func isMostlyLatinString(str string) bool {
 	if len(str) <= 0 {
 		return false
@@ -263,6 +465,15 @@ func isMostlyLatinString(str string) bool {
 	return latinLength > nonLatinLength
 }
 
+// Returns true for normalized count words that can follow their number, e.g "5 серия", "2 сезон"
+func isTrailingCountWord(w string) bool {
+	switch w {
+	case "СЕРИЯ", "СЕРИИ", "ЭПИЗОД", "СЕЗОН":
+		return true
+	}
+	return false
+}
+
 func stringToInt(str string) int {
 	dotIndex := strings.IndexByte(str, '.')
 	if dotIndex != -1 {
@@ -285,29 +496,117 @@ func isHexadecimalString(str string) bool {
 }
 
 func isResolution(str string) bool {
-	pattern := "\\d{3,4}([pP]|([xX\u00D7]\\d{3,4}))$"
+	pattern := "\\d{3,4}([pPiI]|([xX\u00D7]\\d{3,4}))$"
 	found, _ := regexp.Match(pattern, []byte(str))
 	return found
 }
 
+// Converts an ordinal to a number, e.g "2nd", "second", "2ª", "deuxième", "zweite" are all 2.
+// The string is expected to be normalized by keywordManager.normalize.
+// Returns 0 if the string is not an ordinal.
 func getNumberFromOrdinal(str string) int {
 	ordinals := map[string]int{
-		"1st": 1, "first": 1,
-		"2nd": 2, "second": 2,
-		"3rd": 3, "third": 3,
-		"4th": 4, "fourth": 4,
-		"5th": 5, "fifth": 5,
-		"6th": 6, "sixth": 6,
-		"7th": 7, "seventh": 7,
-		"8th": 8, "eighth": 8,
-		"9th": 9, "ninth": 9,
-	}
-
-	lowerStr := strings.ToLower(str)
-	num := ordinals[lowerStr]
+		"1ST": 1, "FIRST": 1, "PREMIERE": 1, "PRIMERA": 1, "PRIMEIRA": 1, "PRIMA": 1, "ERSTE": 1,
+		"2ND": 2, "SECOND": 2, "DEUXIEME": 2, "SECONDE": 2, "SEGUNDA": 2, "SECONDA": 2, "ZWEITE": 2,
+		"3RD": 3, "THIRD": 3, "TROISIEME": 3, "TERCERA": 3, "TERCEIRA": 3, "TERZA": 3, "DRITTE": 3,
+		"4TH": 4, "FOURTH": 4, "QUATRIEME": 4, "CUARTA": 4, "QUARTA": 4, "VIERTE": 4,
+		"5TH": 5, "FIFTH": 5, "CINQUIEME": 5, "QUINTA": 5, "FUNFTE": 5,
+		"6TH": 6, "SIXTH": 6,
+		"7TH": 7, "SEVENTH": 7,
+		"8TH": 8, "EIGHTH": 8,
+		"9TH": 9, "NINTH": 9,
+		"TENTH": 10, "ELEVENTH": 11, "TWELFTH": 12,
+	}
+
+	upperStr := strings.ToUpper(str)
+	if num, found := ordinals[upperStr]; found {
+		return num
+	}
+
+	// e.g "2ª", "2º", "2e", "2ème", "1er", "2." once normalized
+	match := ordinalSuffixRe.FindStringSubmatch(upperStr)
+	if match == nil {
+		return 0
+	}
+	return stringToInt(match[1])
+}
+
+var ordinalSuffixRe = regexp.MustCompile(`^(\d{1,2})(?:ST|ND|RD|TH|A|O|E|EME|ER|ERE|RE|\x{00B0}|\.)$`)
+
+// Converts ordinals, number words and Roman numerals to a number, e.g "SECOND", "TWO" and "II" are 2.
+// Returns 0 if the string is none of them.
+func getNumberFromWord(str string) int {
+	if num := getNumberFromOrdinal(str); num != 0 {
+		return num
+	}
+
+	words := map[string]int{
+		"ONE": 1, "TWO": 2, "THREE": 3, "FOUR": 4, "FIVE": 5, "SIX": 6,
+		"SEVEN": 7, "EIGHT": 8, "NINE": 9, "TEN": 10, "ELEVEN": 11, "TWELVE": 12,
+	}
+
+	upperStr := strings.ToUpper(str)
+	if num, found := words[upperStr]; found {
+		return num
+	}
+	return getNumberFromRoman(upperStr)
+}
+
+// Only the numerals that can number a season are considered, i.e "I" to "XXXIX"
+var romanNumeralRe = regexp.MustCompile(`^X{0,3}(?:IX|IV|V?I{0,3})$`)
+
+// Converts Roman numerals to a number, e.g "IV" is 4, "XII" is 12.
+// Returns 0 if the string is not a Roman numeral.
+func getNumberFromRoman(str string) int {
+	if str == "" || !romanNumeralRe.MatchString(str) {
+		return 0
+	}
+	values := map[byte]int{'I': 1, 'V': 5, 'X': 10}
+
+	num := 0
+	for i := 0; i < len(str); i++ {
+		if i+1 < len(str) && values[str[i]] < values[str[i+1]] {
+			num -= values[str[i]]
+		} else {
+			num += values[str[i]]
+		}
+	}
 	return num
 }
 
+// Converts Chinese numerals to a number, e.g "二" is 2, "十二" is 12.
+// Returns 0 if the string is not a Chinese numeral.
+func getNumberFromCJKNumeral(str string) int {
+	digits := map[rune]int{
+		'〇': 0, '零': 0, '一': 1, '二': 2, '两': 2, '兩': 2, '三': 3, '四': 4,
+		'五': 5, '六': 6, '七': 7, '八': 8, '九': 9,
+	}
+
+	num := 0
+	current := 0
+	for _, r := range str {
+		if d, found := digits[r]; found {
+			current = d
+			continue
+		}
+		multiplier := 0
+		switch r {
+		case '十':
+			multiplier = 10
+		case '百':
+			multiplier = 100
+		default:
+			return 0
+		}
+		if current == 0 {
+			current = 1
+		}
+		num += current * multiplier
+		current = 0
+	}
+	return num + current
+}
+
 func findNumberInString(str string) int {
 	for _, c := range str {
 		if unicode.IsDigit(c) {
@@ -328,3 +627,69 @@ func extractNumbersFromString(str string) string {
 
 	return numericStr
 }
+
+// Split the anime title into its alternative titles, e.g "葬送的芙莉莲 / Sousou no Frieren", "Title A | Title B".
+// The primary title is the first romanized title, or the native title if Options.PreferNativeTitle is set.
+func (p *parser) splitAlternativeTitles() {
+	elems := p.tokenizer.elements
+	titles := splitTitles(elems.AnimeTitle)
+	native := elems.AnimeTitleNative
+	if native != "" {
+		titles = append(titles, native)
+	}
+	if len(titles) < 2 {
+		return
+	}
+
+	primary := ""
+	for _, title := range titles {
+		if isMostlyCJKString(title) {
+			if native == "" {
+				native = title
+			}
+		} else if primary == "" {
+			primary = title
+		}
+	}
+	if primary == "" || (p.tokenizer.options.PreferNativeTitle && native != "") {
+		primary = native
+	}
+	if primary == "" {
+		primary = titles[0]
+	}
+
+	elems.insert(elementCategoryAnimeTitle, primary)
+	if native != "" {
+		elems.insert(elementCategoryAnimeTitleNative, native)
+	}
+	for _, title := range titles {
+		if title != primary && title != native {
+			elems.insert(elementCategoryAnimeTitleAlternatives, title)
+		}
+	}
+}
+
+// Split a title on title separators.
+// "|" and " / " always separate titles, while "/" only separates titles written in different scripts,
+// e.g "葬送的芙莉莲/Sousou no Frieren" but not "Fate/Zero".
+func splitTitles(str string) []string {
+	var titles []string
+	for _, part := range strings.Split(str, "|") {
+		for _, title := range strings.Split(part, " / ") {
+			title = strings.TrimSpace(title)
+			if title == "" {
+				continue
+			}
+			if halves := strings.Split(title, "/"); len(halves) == 2 {
+				first := strings.TrimSpace(halves[0])
+				second := strings.TrimSpace(halves[1])
+				if first != "" && second != "" && isMostlyCJKString(first) != isMostlyCJKString(second) {
+					titles = append(titles, first, second)
+					continue
+				}
+			}
+			titles = append(titles, title)
+		}
+	}
+	return titles
+}
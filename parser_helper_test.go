@@ -156,6 +156,10 @@ func TestParserHelperIsResolution(t *testing.T) {
 	if !ret {
 		t.Error("expected true, got false")
 	}
+	ret = isResolution("1080i")
+	if !ret {
+		t.Error("expected true, got false")
+	}
 }
 
 func TestParserHelperGetNumberFromOrdinal(t *testing.T) {
@@ -167,6 +171,73 @@ func TestParserHelperGetNumberFromOrdinal(t *testing.T) {
 	if i != 0 {
 		t.Errorf("expected 0, got %d", i)
 	}
+	testCases := map[string]int{"2A": 2, "2O": 2, "3E": 3, "2EME": 2, "1ER": 1, "DEUXIEME": 2, "SEGUNDA": 2, "ZWEITE": 2, "2.": 2, "22A": 22, "2X": 0}
+	for str, expected := range testCases {
+		i = getNumberFromOrdinal(str)
+		if i != expected {
+			t.Errorf("%s: expected %d, got %d", str, expected, i)
+		}
+	}
+}
+
+func TestParserHelperGetNumberFromWord(t *testing.T) {
+	testCases := map[string]int{"SECOND": 2, "TENTH": 10, "TWO": 2, "TWELVE": 12, "II": 2, "IV": 4, "XIX": 19, "IIII": 0, "MIX": 0, "": 0}
+	for str, expected := range testCases {
+		i := getNumberFromWord(str)
+		if i != expected {
+			t.Errorf("%s: expected %d, got %d", str, expected, i)
+		}
+	}
+}
+
+func TestParserHelperCheckAnimeCourKeyword(t *testing.T) {
+	e := Parse("[Group] Title 2nd Cour - 03 [1080p].mkv", DefaultOptions)
+	if len(e.AnimeCour) != 1 || e.AnimeCour[0] != "2" {
+		t.Errorf("expected [2], got %v", e.AnimeCour)
+	}
+	if e.AnimeTitle != "Title" {
+		t.Errorf("expected \"Title\", got \"%s\"", e.AnimeTitle)
+	}
+	e = Parse("[Group] Title Cour II - 03 [1080p].mkv", DefaultOptions)
+	if len(e.AnimeCour) != 1 || e.AnimeCour[0] != "2" {
+		t.Errorf("expected [2], got %v", e.AnimeCour)
+	}
+}
+
+func TestParserHelperCheckAnimeSeasonKeywordWord(t *testing.T) {
+	e := Parse("[Group] Title Season Two - 03 [1080p].mkv", DefaultOptions)
+	if len(e.AnimeSeason) != 1 || e.AnimeSeason[0] != "2" {
+		t.Errorf("expected [2], got %v", e.AnimeSeason)
+	}
+	e = Parse("[Group] Title Part II - 03 [1080p].mkv", DefaultOptions)
+	if len(e.AnimePart) != 1 || e.AnimePart[0] != "2" {
+		t.Errorf("expected [2], got %v", e.AnimePart)
+	}
+	e = Parse("The Irregular at Magic High School - S01E01- Enrollment Part I.mkv", DefaultOptions)
+	if len(e.AnimePart) != 0 {
+		t.Errorf("expected [], got %v", e.AnimePart)
+	}
+	e = Parse("Mob Psycho 100 Season II - 03.mkv", DefaultOptions)
+	if len(e.AnimeSeason) != 1 || e.AnimeSeason[0] != "2" {
+		t.Errorf("expected [2], got %v", e.AnimeSeason)
+	}
+	if e.AnimeTitle != "Mob Psycho 100" {
+		t.Errorf("expected \"Mob Psycho 100\", got \"%s\"", e.AnimeTitle)
+	}
+	e = Parse("Re Zero Season 2 Part II - 05.mkv", DefaultOptions)
+	if len(e.AnimePart) != 1 || e.AnimePart[0] != "2" {
+		t.Errorf("expected [2], got %v", e.AnimePart)
+	}
+	if e.EpisodeTitle != "" {
+		t.Errorf("expected \"\", got \"%s\"", e.EpisodeTitle)
+	}
+	e = Parse("[Group] Shingeki no Kyojin The Final Season - 03 [1080p].mkv", DefaultOptions)
+	if !e.IsFinalSeason {
+		t.Error("expected true, got false")
+	}
+	if e.AnimeTitle != "Shingeki no Kyojin The Final Season" {
+		t.Errorf("expected \"Shingeki no Kyojin The Final Season\", got \"%s\"", e.AnimeTitle)
+	}
 }
 
 func TestParserHelperFindNumberInString(t *testing.T) {
@@ -179,3 +250,197 @@ func TestParserHelperFindNumberInString(t *testing.T) {
 		t.Errorf("expected 1, got %d", i)
 	}
 }
+
+func TestParserHelperIsNextToIdentifier(t *testing.T) {
+	psr := getTestParser("Title.CR.FLAC.mkv")
+	var testTkn *token
+	for _, v := range *psr.tokenizer.tokens {
+		if v.Content == "CR" {
+			testTkn = v
+		}
+	}
+	if psr.isNextToIdentifier(testTkn) {
+		t.Error("expected false, got true")
+	}
+	psr.searchForKeywords()
+	if !psr.isNextToIdentifier(testTkn) {
+		t.Error("expected true, got false")
+	}
+}
+
+func TestParserHelperIsTitleString(t *testing.T) {
+	ret := isTitleString("")
+	if ret {
+		t.Error("expected false, got true")
+	}
+	ret = isTitleString("★★★")
+	if ret {
+		t.Error("expected false, got true")
+	}
+	ret = isTitleString("漆黑的子彈")
+	if !ret {
+		t.Error("expected true, got false")
+	}
+	ret = isTitleString("Провожающая в последний путь Фрирен")
+	if !ret {
+		t.Error("expected true, got false")
+	}
+}
+
+func TestParserHelperIsMostlyCJKString(t *testing.T) {
+	ret := isMostlyCJKString("Sousou no Frieren")
+	if ret {
+		t.Error("expected false, got true")
+	}
+	ret = isMostlyCJKString("葬送的芙莉莲")
+	if !ret {
+		t.Error("expected true, got false")
+	}
+	ret = isMostlyCJKString("장송의 프리렌")
+	if !ret {
+		t.Error("expected true, got false")
+	}
+}
+
+func TestParserHelperIsDecorationString(t *testing.T) {
+	ret := isDecorationString("Magical☆Star")
+	if ret {
+		t.Error("expected false, got true")
+	}
+	ret = isDecorationString("★")
+	if !ret {
+		t.Error("expected true, got false")
+	}
+	ret = isDecorationString("★10月新番★")
+	if !ret {
+		t.Error("expected true, got false")
+	}
+	ret = isDecorationString("★10月新番")
+	if !ret {
+		t.Error("expected true, got false")
+	}
+	ret = isDecorationString("☆Star")
+	if ret {
+		t.Error("expected false, got true")
+	}
+}
+
+func TestParserHelperGetNumberFromCJKNumeral(t *testing.T) {
+	testCases := map[string]int{"二": 2, "十": 10, "十二": 12, "二十": 20, "二十五": 25, "test": 0}
+	for str, expected := range testCases {
+		i := getNumberFromCJKNumeral(str)
+		if i != expected {
+			t.Errorf("%s: expected %d, got %d", str, expected, i)
+		}
+	}
+}
+
+func TestParserHelperSplitAlternativeTitles(t *testing.T) {
+	psr := getTestParser("")
+	psr.tokenizer.elements.insert(elementCategoryAnimeTitle, "Fate/Zero")
+	psr.splitAlternativeTitles()
+	if psr.tokenizer.elements.AnimeTitle != "Fate/Zero" {
+		t.Errorf("expected \"Fate/Zero\", got \"%s\"", psr.tokenizer.elements.AnimeTitle)
+	}
+	psr.tokenizer.elements.insert(elementCategoryAnimeTitle, "葬送的芙莉莲 / Sousou no Frieren")
+	psr.splitAlternativeTitles()
+	if psr.tokenizer.elements.AnimeTitle != "Sousou no Frieren" {
+		t.Errorf("expected \"Sousou no Frieren\", got \"%s\"", psr.tokenizer.elements.AnimeTitle)
+	}
+	if psr.tokenizer.elements.AnimeTitleNative != "葬送的芙莉莲" {
+		t.Errorf("expected \"葬送的芙莉莲\", got \"%s\"", psr.tokenizer.elements.AnimeTitleNative)
+	}
+}
+
+func TestParserHelperIsTrailingCountWord(t *testing.T) {
+	ret := isTrailingCountWord("EPISODE")
+	if ret {
+		t.Error("expected false, got true")
+	}
+	ret = isTrailingCountWord("СЕРИЯ")
+	if !ret {
+		t.Error("expected true, got false")
+	}
+}
+
+func TestParserHelperCheckAnimeSeasonKeywordTrailing(t *testing.T) {
+	psr := getTestParser("Магическая битва 2 сезон 5 серия.mkv")
+	psr.searchForKeywords()
+	if len(psr.tokenizer.elements.AnimeSeason) != 1 || psr.tokenizer.elements.AnimeSeason[0] != "2" {
+		t.Errorf("expected [2], got %v", psr.tokenizer.elements.AnimeSeason)
+	}
+	if len(psr.tokenizer.elements.EpisodeNumber) != 1 || psr.tokenizer.elements.EpisodeNumber[0] != "5" {
+		t.Errorf("expected [5], got %v", psr.tokenizer.elements.EpisodeNumber)
+	}
+}
+
+func TestParserHelperCheckSubtitleLanguageKeyword(t *testing.T) {
+	psr := getTestParser("[Kusonime] Title Eps 05 Sub Indo 720p.mkv")
+	psr.searchForKeywords()
+	if len(psr.tokenizer.elements.Language) != 1 || psr.tokenizer.elements.Language[0] != "Indo" {
+		t.Errorf("expected [Indo], got %v", psr.tokenizer.elements.Language)
+	}
+	psr = getTestParser("[Group] Title - 05 Sub Title.mkv")
+	psr.searchForKeywords()
+	if len(psr.tokenizer.elements.Language) != 0 {
+		t.Errorf("expected [], got %v", psr.tokenizer.elements.Language)
+	}
+}
+
+func TestParserHelperSplitTitles(t *testing.T) {
+	testCases := []struct {
+		str      string
+		expected []string
+	}{
+		{"Fate/Zero", []string{"Fate/Zero"}},
+		{"Title A | Title B", []string{"Title A", "Title B"}},
+		{"Title A|Title B", []string{"Title A", "Title B"}},
+		{"Sousou no Frieren / Frieren - Beyond Journey's End", []string{"Sousou no Frieren", "Frieren - Beyond Journey's End"}},
+		{"葬送的芙莉莲/Sousou no Frieren", []string{"葬送的芙莉莲", "Sousou no Frieren"}},
+	}
+	for _, tc := range testCases {
+		titles := splitTitles(tc.str)
+		if !equal(titles, tc.expected) {
+			t.Errorf("expected %v, got %v", tc.expected, titles)
+		}
+	}
+}
+
+func TestParserHelperPreferNativeTitle(t *testing.T) {
+	filename := "[Group] 葬送のフリーレン / Sousou no Frieren / Frieren - Beyond Journey's End - 05 [1080p].mkv"
+	e := Parse(filename, DefaultOptions)
+	if e.AnimeTitle != "Sousou no Frieren" {
+		t.Errorf("expected \"Sousou no Frieren\", got \"%s\"", e.AnimeTitle)
+	}
+	if e.AnimeTitleNative != "葬送のフリーレン" {
+		t.Errorf("expected \"葬送のフリーレン\", got \"%s\"", e.AnimeTitleNative)
+	}
+	if !equal(e.AnimeTitleAlternatives, []string{"Frieren - Beyond Journey's End"}) {
+		t.Errorf("expected [Frieren - Beyond Journey's End], got %v", e.AnimeTitleAlternatives)
+	}
+	options := DefaultOptions
+	options.PreferNativeTitle = true
+	e = Parse(filename, options)
+	if e.AnimeTitle != "葬送のフリーレン" {
+		t.Errorf("expected \"葬送のフリーレン\", got \"%s\"", e.AnimeTitle)
+	}
+	if !equal(e.AnimeTitleAlternatives, []string{"Sousou no Frieren", "Frieren - Beyond Journey's End"}) {
+		t.Errorf("expected [Sousou no Frieren Frieren - Beyond Journey's End], got %v", e.AnimeTitleAlternatives)
+	}
+}
+
+func TestParserHelperIsAloneInBrackets(t *testing.T) {
+	psr := getTestParser("[SubsPlease] Title - 01 [GroupA & HorribleSubs].mkv")
+	for _, tkn := range *psr.tokenizer.tokens {
+		switch tkn.Content {
+		case "SubsPlease":
+			if !psr.isAloneInBrackets(tkn) {
+				t.Error("expected true, got false")
+			}
+		case "HorribleSubs":
+			if psr.isAloneInBrackets(tkn) {
+				t.Error("expected false, got true")
+			}
+		}
+	}
+}
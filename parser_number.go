@@ -4,18 +4,71 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+	"time"
 )
 
-const (
-	animeYearMin     = 1900
-	animeYearMax     = 2050
-	episodeNumberMax = 1899
-	volumeNumberMax  = 20
-)
+// Limits are the numeric bounds used to validate the numbers found in the filename.
+// Zero values fall back to the ones of DefaultLimits.
+type Limits struct {
+	// DefaultLimits value: 1900
+	// Lowest number parsed as the AnimeYear or as the year of the AirDate.
+	AnimeYearMin int
+
+	// DefaultLimits value: 2050
+	// Highest number parsed as the AnimeYear or as the year of the AirDate.
+	AnimeYearMax int
+
+	// DefaultLimits value: 1899
+	// Highest number parsed as the EpisodeNumber when it is not prefixed, e.g "Title - 2100" needs a higher limit.
+	EpisodeNumberMax int
+
+	// DefaultLimits value: 20
+	// Highest number parsed as the VolumeNumber when it is not prefixed.
+	VolumeNumberMax int
+}
+
+// DefaultLimits is the value of Options.Limits in DefaultOptions.
+var DefaultLimits = Limits{
+	AnimeYearMin:     1900,
+	AnimeYearMax:     2050,
+	EpisodeNumberMax: 1899,
+	VolumeNumberMax:  20,
+}
+
+// withDefaults returns the limits with their zero values replaced by the ones of DefaultLimits.
+func (l Limits) withDefaults() Limits {
+	if l.AnimeYearMin == 0 {
+		l.AnimeYearMin = DefaultLimits.AnimeYearMin
+	}
+	if l.AnimeYearMax == 0 {
+		l.AnimeYearMax = DefaultLimits.AnimeYearMax
+	}
+	if l.EpisodeNumberMax == 0 {
+		l.EpisodeNumberMax = DefaultLimits.EpisodeNumberMax
+	}
+	if l.VolumeNumberMax == 0 {
+		l.VolumeNumberMax = DefaultLimits.VolumeNumberMax
+	}
+	return l
+}
+
+func (l Limits) isValidYear(n int) bool {
+	return n >= l.AnimeYearMin && n <= l.AnimeYearMax
+}
 
 func (p *parser) checkExtentKeyword(cat elementCategory, tkn *token) bool {
 	nextToken, _ := p.tokenizer.tokens.findNext(*tkn, tokenFlagsNotDelimiter)
 
+	// Handle count words following their number, e.g "5 серия"
+	if nextToken.empty() || findNumberInString(nextToken.Content) == -1 {
+		if isTrailingCountWord(p.tokenizer.keywordManager.normalize(tkn.Content)) {
+			prevToken, found := p.tokenizer.tokens.findPrevious(*tkn, tokenFlagsNotDelimiter)
+			if found && isNumeric(prevToken.Content) {
+				nextToken = prevToken
+			}
+		}
+	}
+
 	if nextToken.Category == tokenCategoryUnknown {
 		if !nextToken.empty() && findNumberInString(nextToken.Content) > -1 {
 			if cat == elementCategoryEpisodeNumber {
@@ -121,7 +174,7 @@ func (p *parser) numberComesBeforeAnotherNumber(tkn *token) bool {
 // e.g, "25 (01)"
 func (p *parser) searchForEquivalentNumbers(tkns tokens) bool {
 	for _, tkn := range tkns {
-		if p.tokenizer.tokens.isTokenIsolated(*tkn) || !isValidEpisodeNumber(tkn.Content) {
+		if p.tokenizer.tokens.isTokenIsolated(*tkn) || !p.isValidEpisodeNumber(tkn.Content) {
 			return false
 		}
 
@@ -137,7 +190,7 @@ func (p *parser) searchForEquivalentNumbers(tkns tokens) bool {
 		}
 
 		// Do not consider "25 01", "25 a"
-		if !p.tokenizer.tokens.isTokenIsolated(*nextToken) || !isNumeric(nextToken.Content) || !isValidEpisodeNumber(nextToken.Content) {
+		if !p.tokenizer.tokens.isTokenIsolated(*nextToken) || !isNumeric(nextToken.Content) || !p.isValidEpisodeNumber(nextToken.Content) {
 			continue
 		}
 
@@ -249,13 +302,13 @@ func (p *parser) setSeasonNumber(number string, tkn *token) bool {
 	return true
 }
 
-func isValidEpisodeNumber(number string) bool {
-	return stringToInt(number) <= episodeNumberMax
+func (p *parser) isValidEpisodeNumber(number string) bool {
+	return stringToInt(number) <= p.tokenizer.options.Limits.EpisodeNumberMax
 }
 
 func (p *parser) setEpisodeNumber(number string, tkn *token, validate bool) bool {
 	if validate {
-		if !isValidEpisodeNumber(number) {
+		if !p.isValidEpisodeNumber(number) {
 			return false
 		}
 	}
@@ -339,7 +392,7 @@ func (p *parser) matchEpisodePattern(w string, tkn *token) bool {
 			return true
 		}
 	}
-	if numericFront || strings.IndexRune(w, '\u7B2C') == 0 {
+	if numericFront || strings.IndexRune(w, '\u7B2C') == 0 || strings.IndexRune(w, '제') == 0 {
 		if p.matchJapaneseCounterPattern(w, tkn) {
 			return true
 		}
@@ -400,7 +453,8 @@ func (p *parser) matchMultiEpisodePattern(w string, tkn *token) bool {
 }
 
 func (p *parser) matchSeasonAndEpisodePattern(w string, tkn *token) bool {
-	pattern := "(?i)S?(\\d{1,2})(?:-S?(\\d{1,2}))?(?:x|[ ._-x]?E)(\\d{1,4})(?:-E?(\\d{1,4}))?(?:[vV](\\d{1,2}))?$"
+	// "T" is the Spanish, Portuguese and Italian season prefix, e.g "T02E05"
+	pattern := "(?i)[ST]?(\\d{1,2})(?:-S?(\\d{1,2}))?(?:x|[ ._-x]?E)(\\d{1,4})(?:-E?(\\d{1,4}))?(?:[vV](\\d{1,2}))?$"
 	re := regexp.MustCompile(pattern)
 	match := re.FindStringSubmatch(w)
 	if match == nil {
@@ -440,6 +494,18 @@ func (p *parser) matchTypeAndEpisodePattern(w string, tkn *token) bool {
 	if found {
 		p.tokenizer.elements.insert(elementCategoryAnimeType, prefix)
 		number := w[numberBegin:]
+		// Extras are numbered apart from episodes, e.g "NCOP1", "OP4a", "PV3"
+		if _, isExtra := p.extraKind(prefix); isExtra && p.setExtraNumber(number) {
+			tokenIndex := p.tokenizer.tokens.getIndex(*tkn, 0)
+			tkn.Content = number
+			tkn.Category = tokenCategoryIdentifier
+			p.tokenizer.tokens.insert(tokenIndex, token{
+				Category: tokenCategoryIdentifier,
+				Content:  prefix,
+				Enclosed: tkn.Enclosed,
+			})
+			return true
+		}
 		if p.matchEpisodePattern(number, tkn) || p.setEpisodeNumber(number, tkn, true) {
 			tokenIndex := p.tokenizer.tokens.getIndex(*tkn, 0)
 			tkn.Content = number
@@ -447,6 +513,11 @@ func (p *parser) matchTypeAndEpisodePattern(w string, tkn *token) bool {
 			if !kd.options.identifiable {
 				targetCategory = tokenCategoryUnknown
 			}
+			// Specials are flagged and end the title, e.g "SP1", "OVA2"
+			if kind, isSpecial := p.specialKind(prefix); isSpecial {
+				p.tokenizer.elements.SpecialType = kind
+				targetCategory = tokenCategoryIdentifier
+			}
 			p.tokenizer.tokens.insert(tokenIndex, token{
 				Category: targetCategory,
 				Content:  prefix,
@@ -481,8 +552,10 @@ func (p *parser) matchPartialEpisodePattern(w string, tkn *token) bool {
 	}
 	suffix := string(w[nonNumberBegin:])
 
+	// The suffix is the part of the episode, e.g "07a" is the first part of episode 7
 	if len(suffix) == 1 && strings.Contains("ABCabc", suffix) {
-		if p.setEpisodeNumber(w, tkn, true) {
+		if p.setEpisodeNumber(w[:nonNumberBegin], tkn, true) {
+			p.setFilePart("", suffix, tkn)
 			return true
 		}
 	}
@@ -513,21 +586,60 @@ func (p *parser) matchNumberSignPattern(w string, tkn *token) bool {
 	return true
 }
 
+// e.g "12話", "第12话", "第05集", "第五集", "1화", "제1화"
 func (p *parser) matchJapaneseCounterPattern(w string, tkn *token) bool {
-	if strings.IndexRune(w, '\u8A71') == -1 {
+	if !strings.ContainsAny(w, "話话集화") {
 		return false
 	}
 
-	pattern := "(\\d{1,4})話$"
+	pattern := "(?:^[\u7B2C제])?(\\d{1,4}|[〇零一二两兩三四五六七八九十百]+)[話话集화]$"
 	re := regexp.MustCompile(pattern)
 	match := re.FindStringSubmatch(w)
 	if match == nil {
 		return false
 	}
-	p.setEpisodeNumber(match[1], tkn, false)
+	number := match[1]
+	if !isNumeric(number) {
+		number = strconv.Itoa(getNumberFromCJKNumeral(number))
+	}
+	p.setEpisodeNumber(number, tkn, false)
 	return true
 }
 
+// e.g "第2季", "第二季", "2期", "第2期", "2기"
+func (p *parser) matchSeasonCounterPattern(w string, tkn *token) bool {
+	if !strings.ContainsAny(w, "\u5B63期기") {
+		return false
+	}
+
+	pattern := "^[\u7B2C제]?(\\d{1,2}|[一二两兩三四五六七八九十]+)[\u5B63期기]$"
+	re := regexp.MustCompile(pattern)
+	match := re.FindStringSubmatch(w)
+	if match == nil {
+		return false
+	}
+	number := match[1]
+	if !isNumeric(number) {
+		number = strconv.Itoa(getNumberFromCJKNumeral(number))
+	}
+	return p.setSeasonNumber(number, tkn)
+}
+
+// Russian season numbering, e.g "ТВ-2" is the second TV season
+func (p *parser) matchCyrillicSeasonPattern(w string, tkn *token) bool {
+	if !strings.HasPrefix(w, "\u0422\u0412") {
+		return false
+	}
+
+	pattern := "^\u0422\u0412-?(\\d{1,2})$"
+	re := regexp.MustCompile(pattern)
+	match := re.FindStringSubmatch(w)
+	if match == nil {
+		return false
+	}
+	return p.setSeasonNumber(match[1], tkn)
+}
+
 func (p *parser) matchApostropheVersioning(w string, tkn *token) bool {
 	if strings.IndexRune(w, '\u0027') == -1 {
 		return false
@@ -573,17 +685,17 @@ func (p *parser) matchVolumePattern(w string, tkn *token) bool {
 	return false
 }
 
-func isValidVolumeNumber(number string) bool {
+func (p *parser) isValidVolumeNumber(number string) bool {
 	i, err := strconv.Atoi(number)
 	if err != nil {
 		return false
 	}
-	return i <= volumeNumberMax
+	return i <= p.tokenizer.options.Limits.VolumeNumberMax
 }
 
 func (p *parser) setVolumeNumber(number string, tkn *token, validate bool) bool {
 	if validate {
-		if !isValidVolumeNumber(number) {
+		if !p.isValidVolumeNumber(number) {
 			return false
 		}
 	}
@@ -631,3 +743,74 @@ func (p *parser) matchMultiVolumePattern(w string, tkn *token) bool {
 	}
 	return false
 }
+
+// e.g "2023-10-05", "20231005"
+var airDateRe = regexp.MustCompile(`^(\d{4})-?(\d{2})-?(\d{2})$`)
+
+// Returns the date formatted as "2006-01-02" if it is a valid date, e.g "23", "10", "05" is "2023-10-05"
+func (p *parser) formatAirDate(year, month, day string) (string, bool) {
+	if len(year) == 2 {
+		year = "20" + year
+	}
+	y, m, d := stringToInt(year), stringToInt(month), stringToInt(day)
+	if !p.tokenizer.options.Limits.isValidYear(y) {
+		return "", false
+	}
+	date := time.Date(y, time.Month(m), d, 0, 0, 0, 0, time.UTC)
+	if date.Year() != y || int(date.Month()) != m || date.Day() != d {
+		return "", false
+	}
+	return date.Format("2006-01-02"), true
+}
+
+// Find the broadcast date of dailies and raws, so that it is not split into a year and episode numbers,
+// e.g "2023-10-05", "20231005", "2023.10.05", "(23.10.05)"
+func (p *parser) searchForAirDate() {
+	for _, tkn := range p.tokenizer.tokens.getListFlag(tokenFlagsUnknown) {
+		if match := airDateRe.FindStringSubmatch(tkn.Content); match != nil {
+			if date, ok := p.formatAirDate(match[1], match[2], match[3]); ok {
+				p.tokenizer.elements.insert(elementCategoryAirDate, date)
+				tkn.Category = tokenCategoryIdentifier
+				return
+			}
+			continue
+		}
+
+		// Dotted dates are split into several tokens, e.g "23" "." "10" "." "05"
+		if !isNumeric(tkn.Content) || (len(tkn.Content) != 4 && len(tkn.Content) != 2) {
+			continue
+		}
+		tokenIndex := p.tokenizer.tokens.getIndex(*tkn, 0)
+		parts := tokens{tkn}
+		for i := tokenIndex + 1; i <= tokenIndex+4; i++ {
+			next, found := p.tokenizer.tokens.get(i)
+			if !found {
+				break
+			}
+			if (i-tokenIndex)%2 == 1 {
+				if next.Category != tokenCategoryDelimiter || next.Content != "." {
+					break
+				}
+				continue
+			}
+			if next.Category != tokenCategoryUnknown || !isNumeric(next.Content) || len(next.Content) != 2 {
+				break
+			}
+			parts = append(parts, next)
+		}
+		if len(parts) != 3 {
+			continue
+		}
+		// Two-digit years are only dates when enclosed, e.g "(23.10.05)"
+		if len(tkn.Content) == 2 && !tkn.Enclosed {
+			continue
+		}
+		if date, ok := p.formatAirDate(parts[0].Content, parts[1].Content, parts[2].Content); ok {
+			p.tokenizer.elements.insert(elementCategoryAirDate, date)
+			for _, part := range parts {
+				part.Category = tokenCategoryIdentifier
+			}
+			return
+		}
+	}
+}
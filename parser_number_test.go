@@ -233,6 +233,14 @@ func TestParserNumberMatchSeasonAndEpisodePattern(t *testing.T) {
 	if !ret {
 		t.Error("expected true, got false")
 	}
+	psr = getTestParser("")
+	ret = psr.matchSeasonAndEpisodePattern("T02E05", (*psr.tokenizer.tokens)[0])
+	if !ret {
+		t.Error("expected true, got false")
+	}
+	if psr.tokenizer.elements.AnimeSeason[0] != "02" {
+		t.Errorf("expected \"02\", got \"%s\"", psr.tokenizer.elements.AnimeSeason[0])
+	}
 }
 
 func TestParserNumberMatchFractionalEpisodePattern(t *testing.T) {
@@ -290,6 +298,42 @@ func TestParserNumberMatchJapaneseCounterPattern(t *testing.T) {
 	if !ret {
 		t.Error("expected true, got false")
 	}
+	psr = getTestParser("")
+	ret = psr.matchJapaneseCounterPattern("第05集", (*psr.tokenizer.tokens)[0])
+	if !ret {
+		t.Error("expected true, got false")
+	}
+	if psr.tokenizer.elements.EpisodeNumber[0] != "05" {
+		t.Errorf("expected \"05\", got \"%s\"", psr.tokenizer.elements.EpisodeNumber[0])
+	}
+	psr = getTestParser("")
+	ret = psr.matchJapaneseCounterPattern("第十二话", (*psr.tokenizer.tokens)[0])
+	if !ret {
+		t.Error("expected true, got false")
+	}
+	if psr.tokenizer.elements.EpisodeNumber[0] != "12" {
+		t.Errorf("expected \"12\", got \"%s\"", psr.tokenizer.elements.EpisodeNumber[0])
+	}
+}
+
+func TestParserNumberMatchSeasonCounterPattern(t *testing.T) {
+	psr := getTestParser("")
+	ret := psr.matchSeasonCounterPattern("季test", (*psr.tokenizer.tokens)[0])
+	if ret {
+		t.Error("expected false, got true")
+	}
+	ret = psr.matchSeasonCounterPattern("第2季", (*psr.tokenizer.tokens)[0])
+	if !ret {
+		t.Error("expected true, got false")
+	}
+	psr = getTestParser("")
+	ret = psr.matchSeasonCounterPattern("第二季", (*psr.tokenizer.tokens)[0])
+	if !ret {
+		t.Error("expected true, got false")
+	}
+	if psr.tokenizer.elements.AnimeSeason[0] != "2" {
+		t.Errorf("expected \"2\", got \"%s\"", psr.tokenizer.elements.AnimeSeason[0])
+	}
 }
 
 func TestParserNumberMatchVolumePattern(t *testing.T) {
@@ -305,15 +349,16 @@ func TestParserNumberMatchVolumePattern(t *testing.T) {
 }
 
 func TestParserNumberIsValidVolumeNumber(t *testing.T) {
-	ret := isValidVolumeNumber("test")
+	psr := getTestParser("")
+	ret := psr.isValidVolumeNumber("test")
 	if ret {
 		t.Error("expected false, got true")
 	}
-	ret = isValidVolumeNumber("21")
+	ret = psr.isValidVolumeNumber("21")
 	if ret {
 		t.Error("expected false, got true")
 	}
-	ret = isValidVolumeNumber("20")
+	ret = psr.isValidVolumeNumber("20")
 	if !ret {
 		t.Error("expected true, got false")
 	}
@@ -363,6 +408,72 @@ func TestParserNumberMatchMultiVolumePattern(t *testing.T) {
 	}
 }
 
+func TestParserNumberFormatAirDate(t *testing.T) {
+	psr := getTestParser("")
+	date, ret := psr.formatAirDate("23", "10", "05")
+	if !ret {
+		t.Error("expected true, got false")
+	}
+	if date != "2023-10-05" {
+		t.Errorf("expected \"2023-10-05\", got \"%s\"", date)
+	}
+	_, ret = psr.formatAirDate("2023", "02", "30")
+	if ret {
+		t.Error("expected false, got true")
+	}
+	_, ret = psr.formatAirDate("1234", "10", "05")
+	if ret {
+		t.Error("expected false, got true")
+	}
+}
+
+func TestParserNumberSearchForAirDate(t *testing.T) {
+	psr := getTestParser("[Raws] Title 2023-10-05 (MX 1440x1080).ts")
+	psr.searchForAirDate()
+	if psr.tokenizer.elements.AirDate != "2023-10-05" {
+		t.Errorf("expected \"2023-10-05\", got \"%s\"", psr.tokenizer.elements.AirDate)
+	}
+	psr = getTestParser("Title (23.10.05).ts")
+	psr.searchForAirDate()
+	if psr.tokenizer.elements.AirDate != "2023-10-05" {
+		t.Errorf("expected \"2023-10-05\", got \"%s\"", psr.tokenizer.elements.AirDate)
+	}
+	psr = getTestParser("Title 23.10.05.ts")
+	psr.searchForAirDate()
+	if psr.tokenizer.elements.AirDate != "" {
+		t.Errorf("expected \"\", got \"%s\"", psr.tokenizer.elements.AirDate)
+	}
+	e := Parse("Title 20231005.ts", DefaultOptions)
+	if e.AirDate != "2023-10-05" {
+		t.Errorf("expected \"2023-10-05\", got \"%s\"", e.AirDate)
+	}
+	if e.AnimeYear != "" || len(e.EpisodeNumber) != 0 || e.FileChecksum != "" {
+		t.Errorf("expected no year, episode number or checksum, got \"%s\" %v \"%s\"", e.AnimeYear, e.EpisodeNumber, e.FileChecksum)
+	}
+}
+
+func TestParserNumberLimits(t *testing.T) {
+	limits := Limits{EpisodeNumberMax: 3000}.withDefaults()
+	if limits.AnimeYearMin != 1900 || limits.AnimeYearMax != 2050 || limits.VolumeNumberMax != 20 {
+		t.Errorf("expected default limits, got %v", limits)
+	}
+	e := Parse("[Group] Detective Conan - 2100 [1080p].mkv", DefaultOptions)
+	if len(e.EpisodeNumber) != 0 {
+		t.Errorf("expected [], got %v", e.EpisodeNumber)
+	}
+	options := DefaultOptions
+	options.Limits = Limits{EpisodeNumberMax: 3000}
+	e = Parse("[Group] Detective Conan - 2100 [1080p].mkv", options)
+	if len(e.EpisodeNumber) != 1 || e.EpisodeNumber[0] != "2100" {
+		t.Errorf("expected [2100], got %v", e.EpisodeNumber)
+	}
+	options.Limits = Limits{AnimeYearMin: 1950}
+	e = Parse("[Group] Title (1940) [1080p].mkv", options)
+	if e.AnimeYear != "" {
+		t.Errorf("expected \"\", got \"%s\"", e.AnimeYear)
+	}
+}
+
 func getTestParser(filename string) *parser {
 	if filename == "" {
 		filename = "[TaigaSubs]_Toradora!_(2008)_-_01v2_-_Tiger_and_Dragon_[1280x720_H.264_FLAC][1234ABCD].mkv"
@@ -393,3 +504,57 @@ func getTestParser(filename string) *parser {
 
 	return psr
 }
+
+func TestParserNumberMatchCyrillicSeasonPattern(t *testing.T) {
+	psr := getTestParser("")
+	ret := psr.matchCyrillicSeasonPattern("TV-2", (*psr.tokenizer.tokens)[0])
+	if ret {
+		t.Error("expected false, got true")
+	}
+	ret = psr.matchCyrillicSeasonPattern("ТВ-2", (*psr.tokenizer.tokens)[0])
+	if !ret {
+		t.Error("expected true, got false")
+	}
+	if psr.tokenizer.elements.AnimeSeason[0] != "2" {
+		t.Errorf("expected \"2\", got \"%s\"", psr.tokenizer.elements.AnimeSeason[0])
+	}
+}
+
+func TestParserNumberMatchKoreanCounterPattern(t *testing.T) {
+	psr := getTestParser("")
+	ret := psr.matchJapaneseCounterPattern("화1", (*psr.tokenizer.tokens)[0])
+	if ret {
+		t.Error("expected false, got true")
+	}
+	ret = psr.matchJapaneseCounterPattern("1화", (*psr.tokenizer.tokens)[0])
+	if !ret {
+		t.Error("expected true, got false")
+	}
+	psr = getTestParser("")
+	ret = psr.matchJapaneseCounterPattern("제12화", (*psr.tokenizer.tokens)[0])
+	if !ret {
+		t.Error("expected true, got false")
+	}
+	if psr.tokenizer.elements.EpisodeNumber[0] != "12" {
+		t.Errorf("expected \"12\", got \"%s\"", psr.tokenizer.elements.EpisodeNumber[0])
+	}
+}
+
+func TestParserNumberMatchSeasonCounterPatternKorean(t *testing.T) {
+	for _, w := range []string{"2기", "2期", "第2期", "제2기"} {
+		psr := getTestParser("")
+		ret := psr.matchSeasonCounterPattern(w, (*psr.tokenizer.tokens)[0])
+		if !ret {
+			t.Errorf("%s: expected true, got false", w)
+			continue
+		}
+		if psr.tokenizer.elements.AnimeSeason[0] != "2" {
+			t.Errorf("%s: expected \"2\", got \"%s\"", w, psr.tokenizer.elements.AnimeSeason[0])
+		}
+	}
+	psr := getTestParser("")
+	ret := psr.matchSeasonCounterPattern("기2", (*psr.tokenizer.tokens)[0])
+	if ret {
+		t.Error("expected false, got true")
+	}
+}
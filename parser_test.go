@@ -65,6 +65,28 @@ func TestParserSearchForReleaseGroup(t *testing.T) {
 	}
 }
 
+func TestParserSearchForReleaseGroupPosition(t *testing.T) {
+	e := Parse("Title - 05 (1080p) [Group].mkv", DefaultOptions)
+	if e.ReleaseGroup != "Group" {
+		t.Errorf("expected \"Group\", got \"%s\"", e.ReleaseGroup)
+	}
+	e = Parse("Title - 05 -Group.mkv", DefaultOptions)
+	if e.ReleaseGroup != "Group" {
+		t.Errorf("expected \"Group\", got \"%s\"", e.ReleaseGroup)
+	}
+	if e.EpisodeTitle != "" {
+		t.Errorf("expected \"\", got \"%s\"", e.EpisodeTitle)
+	}
+	e = Parse("Title - 05 [Multi Subs].mkv", DefaultOptions)
+	if e.ReleaseGroup != "" {
+		t.Errorf("expected \"\", got \"%s\"", e.ReleaseGroup)
+	}
+	e = Parse("Title [ru_jp] - 05 [SubsPlease].mkv", DefaultOptions)
+	if e.ReleaseGroup != "SubsPlease" {
+		t.Errorf("expected \"SubsPlease\", got \"%s\"", e.ReleaseGroup)
+	}
+}
+
 func TestParserSearchForEpisodeTitle(t *testing.T) {
 	psr := getTestParser("")
 	psr.searchForKeywords()
@@ -76,4 +98,150 @@ func TestParserSearchForEpisodeTitle(t *testing.T) {
 	if psr.tokenizer.elements.EpisodeTitle != "Tiger and Dragon" {
 		t.Errorf("expected \"Tiger and Dragon\", got \"%s\"", psr.tokenizer.elements.EpisodeTitle)
 	}
+	e := Parse("【极影字幕社】★10月新番 进击的巨人 第五集 GB 720P", DefaultOptions)
+	if e.AnimeTitle != "进击的巨人" {
+		t.Errorf("expected \"进击的巨人\", got \"%s\"", e.AnimeTitle)
+	}
+	if e.EpisodeTitle != "" {
+		t.Errorf("expected \"\", got \"%s\"", e.EpisodeTitle)
+	}
+	if !equal(e.Subtitles, []string{"GB"}) {
+		t.Errorf("expected [GB], got %v", e.Subtitles)
+	}
+}
+
+func TestParserSearchForAmbiguousKeywords(t *testing.T) {
+	psr := getTestParser("[Group] Title CR - 01 [1080p].mkv")
+	psr.searchForKeywords()
+	psr.searchForAmbiguousKeywords()
+	if psr.tokenizer.elements.StreamingService != "" {
+		t.Errorf("expected \"\", got \"%s\"", psr.tokenizer.elements.StreamingService)
+	}
+	psr = getTestParser("[Group] Title - 01 [1080p CR WEB].mkv")
+	psr.searchForKeywords()
+	psr.searchForAmbiguousKeywords()
+	if psr.tokenizer.elements.StreamingService != "CR" {
+		t.Errorf("expected \"CR\", got \"%s\"", psr.tokenizer.elements.StreamingService)
+	}
+	if len(psr.tokenizer.elements.Source) != 1 || psr.tokenizer.elements.Source[0] != "WEB" {
+		t.Errorf("expected [WEB], got %v", psr.tokenizer.elements.Source)
+	}
+	psr = getTestParser("Title.S01E05.1080p.NF.WEB-DL.mkv")
+	psr.searchForKeywords()
+	psr.searchForAmbiguousKeywords()
+	if psr.tokenizer.elements.StreamingService != "NF" {
+		t.Errorf("expected \"NF\", got \"%s\"", psr.tokenizer.elements.StreamingService)
+	}
+}
+
+func TestParserSearchForBracketedTitle(t *testing.T) {
+	e := Parse("[Group] Sousou no Frieren 「葬送のフリーレン」 - 05 [1080p].mkv", DefaultOptions)
+	if e.AnimeTitleNative != "葬送のフリーレン" {
+		t.Errorf("expected \"葬送のフリーレン\", got \"%s\"", e.AnimeTitleNative)
+	}
+	e = Parse("3(無修正)[milky]燐月 リンゲツ THE ANIMATION 第1話 「宿命の契り」.zip", DefaultOptions)
+	if e.AnimeTitleNative != "" {
+		t.Errorf("expected \"\", got \"%s\"", e.AnimeTitleNative)
+	}
+}
+
+func TestParserResolveMediaKind(t *testing.T) {
+	e := Parse("[Group] Title - NCOP1 [1080p].mkv", DefaultOptions)
+	if e.MediaKind != MediaKindOpening {
+		t.Errorf("expected \"%s\", got \"%s\"", MediaKindOpening, e.MediaKind)
+	}
+	e = Parse("[FFF] Love Live! The School Idol Movie - PV [D1A15D2C].mkv", DefaultOptions)
+	if e.MediaKind != MediaKindPreview {
+		t.Errorf("expected \"%s\", got \"%s\"", MediaKindPreview, e.MediaKind)
+	}
+	e = Parse("[Group] Title OVA - 02.mkv", DefaultOptions)
+	if e.MediaKind != MediaKindOVA {
+		t.Errorf("expected \"%s\", got \"%s\"", MediaKindOVA, e.MediaKind)
+	}
+	e = Parse("[Group] Title (2019) [BD 1080p].mkv", DefaultOptions)
+	if e.MediaKind != MediaKindMovie {
+		t.Errorf("expected \"%s\", got \"%s\"", MediaKindMovie, e.MediaKind)
+	}
+	e = Parse("Title S00E04.mkv", DefaultOptions)
+	if e.MediaKind != MediaKindSpecial {
+		t.Errorf("expected \"%s\", got \"%s\"", MediaKindSpecial, e.MediaKind)
+	}
+	e = Parse("[Group] Title - 05 [1080p].mkv", DefaultOptions)
+	if e.MediaKind != MediaKindEpisode {
+		t.Errorf("expected \"%s\", got \"%s\"", MediaKindEpisode, e.MediaKind)
+	}
+	e = Parse("[Judas] Kimi ni Todoke (Seasons 1-2) [BD 1080p]", DefaultOptions)
+	if e.MediaKind != "" {
+		t.Errorf("expected \"\", got \"%s\"", e.MediaKind)
+	}
+	e = Parse("Kimi no Na wa (2016) [BD Remux 1080p].mkv", DefaultOptions)
+	if e.MediaKind != MediaKindMovie {
+		t.Errorf("expected \"%s\", got \"%s\"", MediaKindMovie, e.MediaKind)
+	}
+	e = Parse("Kimi no Na wa (2016) [REPACK].mkv", DefaultOptions)
+	if e.MediaKind != MediaKindMovie {
+		t.Errorf("expected \"%s\", got \"%s\"", MediaKindMovie, e.MediaKind)
+	}
+	e = Parse("The End of Evangelion (1997).mkv", DefaultOptions)
+	if e.MediaKind != MediaKindMovie {
+		t.Errorf("expected \"%s\", got \"%s\"", MediaKindMovie, e.MediaKind)
+	}
+	e = Parse("[Group] Title (2019) [Batch].mkv", DefaultOptions)
+	if e.MediaKind != "" {
+		t.Errorf("expected \"\", got \"%s\"", e.MediaKind)
+	}
+	e = Parse("[Group] Summer Pool Opening - 03.mkv", DefaultOptions)
+	if e.MediaKind != MediaKindEpisode {
+		t.Errorf("expected \"episode\", got \"%s\"", e.MediaKind)
+	}
+	e = Parse("[Group] The Menu - 05.mkv", DefaultOptions)
+	if e.MediaKind != MediaKindEpisode {
+		t.Errorf("expected \"episode\", got \"%s\"", e.MediaKind)
+	}
+}
+
+func TestParserSearchForBroadcaster(t *testing.T) {
+	e := Parse("[Raws] Title - 05 (AT-X 1280x720).mp4", DefaultOptions)
+	if e.Broadcaster != "AT-X" {
+		t.Errorf("expected \"AT-X\", got \"%s\"", e.Broadcaster)
+	}
+	e = Parse("[Raws] Title 2023-10-05 (MX 1440x1080).ts", DefaultOptions)
+	if e.Broadcaster != "MX" {
+		t.Errorf("expected \"MX\", got \"%s\"", e.Broadcaster)
+	}
+	if e.AnimeTitle != "Title" {
+		t.Errorf("expected \"Title\", got \"%s\"", e.AnimeTitle)
+	}
+	e = Parse("[Group] Welcome to the NHK - 05 [720p].mkv", DefaultOptions)
+	if e.Broadcaster != "" {
+		t.Errorf("expected \"\", got \"%s\"", e.Broadcaster)
+	}
+	if e.AnimeTitle != "Welcome to the NHK" {
+		t.Errorf("expected \"Welcome to the NHK\", got \"%s\"", e.AnimeTitle)
+	}
+	e = Parse("NHK ni Youkoso! - 05.mkv", DefaultOptions)
+	if e.AnimeTitle != "NHK ni Youkoso!" {
+		t.Errorf("expected \"NHK ni Youkoso!\", got \"%s\"", e.AnimeTitle)
+	}
+}
+
+func TestParserSplitEpisodeTitles(t *testing.T) {
+	e := Parse("[Group] Title - 01-02 - Title A & Title B [1080p].mkv", DefaultOptions)
+	if len(e.EpisodeTitles) != 2 || e.EpisodeTitles[0] != "Title A" || e.EpisodeTitles[1] != "Title B" {
+		t.Errorf("expected [Title A Title B], got %v", e.EpisodeTitles)
+	}
+	if e.EpisodeTitle != "Title A & Title B" {
+		t.Errorf("expected \"Title A & Title B\", got \"%s\"", e.EpisodeTitle)
+	}
+	e = Parse("[Group] Title - 05 - Part One / 06 - Part Two [1080p].mkv", DefaultOptions)
+	if len(e.EpisodeTitles) != 2 || e.EpisodeTitles[0] != "Part One" || e.EpisodeTitles[1] != "Part Two" {
+		t.Errorf("expected [Part One Part Two], got %v", e.EpisodeTitles)
+	}
+	if len(e.EpisodeNumber) != 2 || e.EpisodeNumber[1] != "06" {
+		t.Errorf("expected [05 06], got %v", e.EpisodeNumber)
+	}
+	e = Parse("Title - 01 - Alpha & Beta.mkv", DefaultOptions)
+	if e.EpisodeTitles != nil {
+		t.Errorf("expected nil, got %v", e.EpisodeTitles)
+	}
 }
@@ -0,0 +1,118 @@
+package tanuki
+
+import (
+	"encoding/json"
+	"io"
+	"regexp"
+	"strings"
+)
+
+// ReleaseGroupAliases maps normalized release group names to their canonical name, e.g "ERAI-RAWS" to "Erai-raws".
+// Canonical names are also keys of their own, so that names are matched case-insensitively with a single lookup.
+// Aliases are built with NewReleaseGroupAliases or LoadReleaseGroupAliases.
+type ReleaseGroupAliases map[string]string
+
+// Canonical names of the release groups registered in newKeywordManager, indexed by normalized keyword.
+var defaultReleaseGroupAliases = ReleaseGroupAliases{
+	"THORA":        "THORA",
+	"HORRIBLESUBS": "HorribleSubs",
+	"ERAI-RAWS":    "Erai-raws",
+	"SUBSPLEASE":   "SubsPlease",
+}
+
+// e.g "GroupA & GroupB", "Hakugetsu&Speed&MGRT"
+var releaseGroupAmpersandRe = regexp.MustCompile(`[ _]*&[ _]*`)
+
+// e.g "GroupA x GroupB", "GroupA × GroupB"
+var releaseGroupCrossRe = regexp.MustCompile(`[ _]+[xX\x{00D7}][ _]+`)
+
+// NewReleaseGroupAliases builds the aliases of a map of release group names to their canonical name,
+// e.g {"Erai raws": "Erai-raws"}.
+//
+// The returned aliases can be set in Options.ReleaseGroupAliases.
+func NewReleaseGroupAliases(names map[string]string) ReleaseGroupAliases {
+	km := newKeywordManager()
+	aliases := ReleaseGroupAliases{}
+	for _, canonical := range names {
+		aliases[km.normalize(canonical)] = canonical
+	}
+	for name, canonical := range names {
+		aliases[km.normalize(name)] = canonical
+	}
+	return aliases
+}
+
+// LoadReleaseGroupAliases reads a JSON object of canonical release group names along with their aliases,
+// e.g {"Erai-raws": ["ERAI-RAWS", "Erai raws"]}.
+//
+// The returned aliases can be set in Options.ReleaseGroupAliases.
+func LoadReleaseGroupAliases(r io.Reader) (ReleaseGroupAliases, error) {
+	var table map[string][]string
+	if err := json.NewDecoder(r).Decode(&table); err != nil {
+		return nil, err
+	}
+
+	names := map[string]string{}
+	for canonical, aliases := range table {
+		names[canonical] = canonical
+		for _, name := range aliases {
+			names[name] = canonical
+		}
+	}
+	return NewReleaseGroupAliases(names), nil
+}
+
+// canonical returns the canonical name of a release group, or the name itself if it has no alias.
+func (a ReleaseGroupAliases) canonical(km *keywordManager, name string) string {
+	key := km.normalize(name)
+	if v, found := a[key]; found {
+		return v
+	}
+	if v, found := defaultReleaseGroupAliases[key]; found {
+		return v
+	}
+	return name
+}
+
+// contains returns true if the name is an alias or a canonical name, e.g "Erai raws" and "Erai-raws".
+func (a ReleaseGroupAliases) contains(km *keywordManager, name string) bool {
+	key := km.normalize(name)
+	if _, found := a[key]; found {
+		return true
+	}
+	_, found := defaultReleaseGroupAliases[key]
+	return found
+}
+
+// splitReleaseGroup splits a collaboration into its release groups, e.g "GroupA & GroupB".
+// Parts shorter than two characters are not considered groups, e.g "BM&T" is a single group.
+// "x" also appears in names, e.g "Spy x Family Fansubs", so it only separates groups when one of them is known.
+func splitReleaseGroup(releaseGroup string, isKnown func(string) bool) []string {
+	var groups []string
+	for _, group := range splitReleaseGroupOn(releaseGroupAmpersandRe, releaseGroup) {
+		parts := splitReleaseGroupOn(releaseGroupCrossRe, group)
+		known := false
+		for _, part := range parts {
+			if isKnown(part) {
+				known = true
+				break
+			}
+		}
+		if known {
+			groups = append(groups, parts...)
+		} else {
+			groups = append(groups, group)
+		}
+	}
+	return groups
+}
+
+func splitReleaseGroupOn(re *regexp.Regexp, releaseGroup string) []string {
+	parts := re.Split(releaseGroup, -1)
+	for _, part := range parts {
+		if len(strings.TrimSpace(part)) < 2 {
+			return []string{releaseGroup}
+		}
+	}
+	return parts
+}
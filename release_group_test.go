@@ -0,0 +1,98 @@
+package tanuki
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestReleaseGroupSplitReleaseGroup(t *testing.T) {
+	testCases := []struct {
+		releaseGroup string
+		expected     []string
+	}{
+		{"GroupA & GroupB", []string{"GroupA", "GroupB"}},
+		{"GroupA x SubsPlease", []string{"GroupA", "SubsPlease"}},
+		{"Spy x Family Fansubs", []string{"Spy x Family Fansubs"}},
+		{"Hakugetsu&Speed&MGRT", []string{"Hakugetsu", "Speed", "MGRT"}},
+		{"B-G_&_m.3.3.w", []string{"B-G", "m.3.3.w"}},
+		{"BM&T", []string{"BM&T"}},
+		{"Exiled-Destiny", []string{"Exiled-Destiny"}},
+	}
+	isKnown := func(name string) bool {
+		return name == "SubsPlease"
+	}
+	for _, tc := range testCases {
+		groups := splitReleaseGroup(tc.releaseGroup, isKnown)
+		if !equal(groups, tc.expected) {
+			t.Errorf("expected %v, got %v", tc.expected, groups)
+		}
+	}
+}
+
+func TestReleaseGroupLoadReleaseGroupAliases(t *testing.T) {
+	aliases, err := LoadReleaseGroupAliases(strings.NewReader(`{"Erai-raws": ["Erai raws"], "SubsPlease": []}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	km := newKeywordManager()
+	testCases := map[string]string{"ERAI RAWS": "Erai-raws", "subsplease": "SubsPlease", "Unknown": "Unknown"}
+	for name, expected := range testCases {
+		canonical := aliases.canonical(km, name)
+		if canonical != expected {
+			t.Errorf("expected \"%s\", got \"%s\"", expected, canonical)
+		}
+	}
+
+	aliases = NewReleaseGroupAliases(map[string]string{"Erai raws": "Erai-raws"})
+	if aliases["ERAI RAWS"] != "Erai-raws" || aliases["ERAI-RAWS"] != "Erai-raws" {
+		t.Errorf("expected normalized keys, got %v", aliases)
+	}
+
+	_, err = LoadReleaseGroupAliases(strings.NewReader(`["Erai-raws"]`))
+	if err == nil {
+		t.Error("expected error, got nil")
+	}
+}
+
+func TestReleaseGroupParse(t *testing.T) {
+	e := Parse("[ERAI-RAWS & HorribleSubs] Title - 05 [1080p].mkv", DefaultOptions)
+	if e.ReleaseGroup != "ERAI-RAWS & HorribleSubs" {
+		t.Errorf("expected \"ERAI-RAWS & HorribleSubs\", got \"%s\"", e.ReleaseGroup)
+	}
+	if !equal(e.ReleaseGroups, []string{"Erai-raws", "HorribleSubs"}) {
+		t.Errorf("expected [Erai-raws HorribleSubs], got %v", e.ReleaseGroups)
+	}
+
+	e = Parse("[SubsPlease x HorribleSubs] Title - 05 [1080p].mkv", DefaultOptions)
+	if !equal(e.ReleaseGroups, []string{"SubsPlease", "HorribleSubs"}) {
+		t.Errorf("expected [SubsPlease HorribleSubs], got %v", e.ReleaseGroups)
+	}
+	e = Parse("[Spy x Family Fansubs] Spy x Family - 05 [1080p].mkv", DefaultOptions)
+	if !equal(e.ReleaseGroups, []string{"Spy x Family Fansubs"}) {
+		t.Errorf("expected [Spy x Family Fansubs], got %v", e.ReleaseGroups)
+	}
+	e = Parse("[GroupA & HorribleSubs] Title - 05 [1080p].mkv", DefaultOptions)
+	if !equal(e.ReleaseGroups, []string{"GroupA", "HorribleSubs"}) {
+		t.Errorf("expected [GroupA HorribleSubs], got %v", e.ReleaseGroups)
+	}
+	e = Parse("[GroupA & GroupB] Title - 05 [1080p].mkv", DefaultOptions)
+	if !equal(e.ReleaseGroups, []string{"GroupA", "GroupB"}) {
+		t.Errorf("expected [GroupA GroupB], got %v", e.ReleaseGroups)
+	}
+	e = Parse("[GroupA x SubsPlease] Title - 05 [1080p].mkv", DefaultOptions)
+	if !equal(e.ReleaseGroups, []string{"GroupA", "SubsPlease"}) {
+		t.Errorf("expected [GroupA SubsPlease], got %v", e.ReleaseGroups)
+	}
+
+	options := DefaultOptions
+	options.ReleaseGroupAliases = NewReleaseGroupAliases(map[string]string{"erai-raws": "Erai"})
+	e = Parse("[ERAI-RAWS] Title - 05 [1080p].mkv", options)
+	if !equal(e.ReleaseGroups, []string{"Erai"}) {
+		t.Errorf("expected [Erai], got %v", e.ReleaseGroups)
+	}
+	// Canonical names are known release groups as well as their aliases
+	e = Parse("[Foo] Title - 05 [Erai].mkv", options)
+	if e.ReleaseGroup != "Erai" {
+		t.Errorf("expected \"Erai\", got \"%s\"", e.ReleaseGroup)
+	}
+}
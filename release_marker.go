@@ -0,0 +1,88 @@
+package tanuki
+
+import (
+	"regexp"
+)
+
+// e.g "V2" in "[v2]"
+var releaseVersionMarkerRe = regexp.MustCompile(`^V(\d)$`)
+
+// e.g "END" in "Title - 12 END"
+func isFinalWord(w string) bool {
+	switch w {
+	case "END", "FINAL":
+		return true
+	}
+	return false
+}
+
+// e.g "REPACK" in "Title.S01E05.REPACK.1080p"
+func isRepackWord(w string) bool {
+	switch w {
+	case "REPACK", "PROPER", "FIXED":
+		return true
+	}
+	return false
+}
+
+// Returns true if the token is a marker of the release rather than a word of a title,
+// i.e it is alone in its brackets, e.g "[END]", or it directly follows the episode number and ends the section, e.g "12 END [1080p]".
+func (p *parser) isReleaseMarker(tkn *token) bool {
+	if p.isAloneInBrackets(tkn) {
+		return true
+	}
+	prevToken, found := p.tokenizer.tokens.findPrevious(*tkn, tokenFlagsNotDelimiter)
+	if !found || prevToken.Category != tokenCategoryIdentifier || findNumberInString(prevToken.Content) == -1 {
+		return false
+	}
+	if !p.tokenizer.elements.contains(elementCategoryEpisodeNumber) {
+		return false
+	}
+	nextToken, found := p.tokenizer.tokens.findNext(*tkn, tokenFlagsNotDelimiter)
+	return !found || nextToken.Category != tokenCategoryUnknown
+}
+
+// Find the final episode and repack markers, and build the effective version of the release.
+// "END" and "FINAL" are only markers after the episode number or alone in brackets, e.g "The End of Evangelion" is a title.
+func (p *parser) searchForReleaseMarkers() {
+	km := p.tokenizer.keywordManager
+	elems := p.tokenizer.elements
+
+	repacked := false
+	for _, tkn := range *p.tokenizer.tokens {
+		w := km.normalize(tkn.Content)
+		switch {
+		case tkn.Category == tokenCategoryUnknown && isFinalWord(w) && p.isReleaseMarker(tkn):
+			elems.IsFinal = true
+			tkn.Category = tokenCategoryIdentifier
+		case tkn.Category == tokenCategoryUnknown && isRepackWord(w) && p.isReleaseMarker(tkn):
+			repacked = true
+			tkn.Category = tokenCategoryIdentifier
+		case tkn.Category == tokenCategoryIdentifier && isRepackWord(w):
+			// Only identifiable keywords are identified before the title is built, e.g "REPACK"
+			if kd, found := km.find(w, elementCategoryReleaseInformation); found && kd.options.identifiable {
+				repacked = true
+			}
+		case releaseVersionMarkerRe.MatchString(w) && p.isAloneInBrackets(tkn): // e.g "[v2]"
+			elems.IsRepack = true
+		}
+	}
+	if repacked {
+		elems.IsRepack = true
+	}
+
+	version := 0
+	for _, v := range elems.ReleaseVersion {
+		if isNumeric(v) && stringToInt(v) > version {
+			version = stringToInt(v)
+		}
+	}
+	if repacked {
+		// A repack of "v2" is the third version of the release
+		if version == 0 {
+			version = 1
+		}
+		version++
+	}
+	elems.EffectiveVersion = version
+}
@@ -0,0 +1,52 @@
+package tanuki
+
+import "testing"
+
+func TestReleaseMarkerIsFinal(t *testing.T) {
+	e := Parse("[Group] Title - 12 END [1080p].mkv", DefaultOptions)
+	if !e.IsFinal {
+		t.Error("expected true, got false")
+	}
+	if e.EpisodeTitle != "" {
+		t.Errorf("expected \"\", got \"%s\"", e.EpisodeTitle)
+	}
+	e = Parse("The End of Evangelion (1997) [BD 1080p].mkv", DefaultOptions)
+	if e.IsFinal {
+		t.Error("expected false, got true")
+	}
+	if e.AnimeTitle != "The End of Evangelion" {
+		t.Errorf("expected \"The End of Evangelion\", got \"%s\"", e.AnimeTitle)
+	}
+	e = Parse("[Group] Title - 12 - Final Battle [1080p].mkv", DefaultOptions)
+	if e.IsFinal {
+		t.Error("expected false, got true")
+	}
+}
+
+func TestReleaseMarkerEffectiveVersion(t *testing.T) {
+	e := Parse("[Group] Title - 05 REPACK [1080p].mkv", DefaultOptions)
+	if !e.IsRepack {
+		t.Error("expected true, got false")
+	}
+	if e.EffectiveVersion != 2 {
+		t.Errorf("expected 2, got %d", e.EffectiveVersion)
+	}
+	e = Parse("[Group] Title - 05v2 REPACK [1080p].mkv", DefaultOptions)
+	if e.EffectiveVersion != 3 {
+		t.Errorf("expected 3, got %d", e.EffectiveVersion)
+	}
+	e = Parse("[Group] Title - 05 [v2] [1080p].mkv", DefaultOptions)
+	if !e.IsRepack {
+		t.Error("expected true, got false")
+	}
+	if e.EffectiveVersion != 2 {
+		t.Errorf("expected 2, got %d", e.EffectiveVersion)
+	}
+	e = Parse("[Group] Title - 05 [1080p].mkv", DefaultOptions)
+	if e.IsRepack {
+		t.Error("expected false, got true")
+	}
+	if e.EffectiveVersion != 0 {
+		t.Errorf("expected 0, got %d", e.EffectiveVersion)
+	}
+}
@@ -0,0 +1,170 @@
+package tanuki
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Resolution is a structured representation of the video resolution found in a filename.
+//
+// It is derived from the raw VideoResolution element ("1080p", "1920x1080", "1080")
+// or, when no resolution is present, from resolution video terms such as "4K", "HD" or "SD".
+type Resolution struct {
+	// Width of the video in pixels. Only set when the filename specifies it, e.g "1920x1080".
+	Width int `json:"width,omitempty"`
+
+	// Height of the video in pixels, e.g 1080 in "1080p" or "1920x1080".
+	Height int `json:"height,omitempty"`
+
+	// True if the video is interlaced, e.g "1080i".
+	Interlaced bool `json:"interlaced,omitempty"`
+
+	// Normalized label of the resolution, e.g "2160p", "1080p", "720p", "480p".
+	// Non-standard resolutions are normalized to the closest standard label, "1920x800" is "1080p".
+	Label string `json:"label,omitempty"`
+}
+
+// Standard resolution heights, from highest to lowest, along with the minimum
+// height and width required for a resolution to belong to that label.
+var resolutionLabels = []struct {
+	height    int
+	minHeight int
+	minWidth  int
+}{
+	{2160, 1800, 3200},
+	{1440, 1200, 2200},
+	{1080, 900, 1600},
+	{720, 600, 1100},
+	{576, 540, 0},
+	{480, 400, 0},
+	{360, 0, 0},
+}
+
+// Resolutions associated with video terms
+var resolutionTerms = map[string]int{
+	"4K":  2160,
+	"UHD": 2160,
+	"FHD": 1080,
+	"HD":  720,
+	"SD":  480,
+}
+
+var resolutionRe = regexp.MustCompile(`^(?:(\d{3,4})[xX\x{00D7}])?(\d{3,4})([pPiI])?$`)
+
+// Progressive returns true if the video is not interlaced.
+func (r *Resolution) Progressive() bool {
+	return !r.Interlaced
+}
+
+// Compare returns 1 if r is a better resolution than other, -1 if it is worse and 0 if they are equivalent.
+//
+// Resolutions are compared by their normalized label first, progressive resolutions are preferred over
+// interlaced ones and the pixel count is used as a tie-breaker when both widths are known,
+// e.g "1920x1080" is better than "1440x1080", but equivalent to "1080p".
+// A nil Resolution is always worse than a non-nil one.
+func (r *Resolution) Compare(other *Resolution) int {
+	if r == nil || other == nil {
+		switch {
+		case r == other:
+			return 0
+		case r == nil:
+			return -1
+		default:
+			return 1
+		}
+	}
+
+	if c := compareInt(r.labelHeight(), other.labelHeight()); c != 0 {
+		return c
+	}
+	if r.Interlaced != other.Interlaced {
+		if r.Interlaced {
+			return -1
+		}
+		return 1
+	}
+	if r.Width == 0 || r.Height == 0 || other.Width == 0 || other.Height == 0 {
+		return 0
+	}
+	return compareInt(r.Width*r.Height, other.Width*other.Height)
+}
+
+// IsBetterThan returns true if r is a strictly better resolution than other.
+func (r *Resolution) IsBetterThan(other *Resolution) bool {
+	return r.Compare(other) > 0
+}
+
+func (r *Resolution) labelHeight() int {
+	return stringToInt(strings.TrimRight(r.Label, "pi"))
+}
+
+// parseResolution converts a raw resolution string into a Resolution.
+// Returns nil if the string is not a resolution.
+func parseResolution(str string) *Resolution {
+	if height, found := resolutionTerms[strings.ToUpper(str)]; found {
+		return newResolution(0, height, false)
+	}
+
+	match := resolutionRe.FindStringSubmatch(str)
+	if match == nil {
+		return nil
+	}
+	// "1080" alone is a height, "1920x1080p" is not a valid resolution
+	if match[1] != "" && match[3] != "" {
+		return nil
+	}
+
+	width, _ := strconv.Atoi(match[1])
+	height, _ := strconv.Atoi(match[2])
+	interlaced := strings.EqualFold(match[3], "i")
+
+	return newResolution(width, height, interlaced)
+}
+
+func newResolution(width, height int, interlaced bool) *Resolution {
+	if height == 0 {
+		return nil
+	}
+
+	label := 0
+	for _, v := range resolutionLabels {
+		if height >= v.minHeight || (v.minWidth != 0 && width >= v.minWidth) {
+			label = v.height
+			break
+		}
+	}
+
+	suffix := "p"
+	if interlaced {
+		suffix = "i"
+	}
+
+	return &Resolution{
+		Width:      width,
+		Height:     height,
+		Interlaced: interlaced,
+		Label:      strconv.Itoa(label) + suffix,
+	}
+}
+
+// isResolutionNumber returns true if an isolated number is a commonly used resolution height.
+// Other heights are also episode numbers of long-running shows, e.g "576", so they need a suffix or a width, e.g "576p".
+func isResolutionNumber(n int) bool {
+	for _, v := range []int{480, 720, 1080} {
+		if n == v {
+			return true
+		}
+	}
+	return false
+}
+
+func compareInt(a, b int) int {
+	switch {
+	case a > b:
+		return 1
+	case a < b:
+		return -1
+	}
+	return 0
+}
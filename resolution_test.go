@@ -0,0 +1,100 @@
+package tanuki
+
+import (
+	"testing"
+)
+
+func TestResolutionParseResolution(t *testing.T) {
+	r := parseResolution("test")
+	if r != nil {
+		t.Errorf("expected nil, got %+v", r)
+	}
+	r = parseResolution("1920x1080p")
+	if r != nil {
+		t.Errorf("expected nil, got %+v", r)
+	}
+	r = parseResolution("1920x1080")
+	if r == nil || r.Width != 1920 || r.Height != 1080 || r.Label != "1080p" {
+		t.Errorf("expected {1920 1080 false 1080p}, got %+v", r)
+	}
+	r = parseResolution("1080i")
+	if r == nil || !r.Interlaced || r.Progressive() || r.Label != "1080i" {
+		t.Errorf("expected {0 1080 true 1080i}, got %+v", r)
+	}
+	r = parseResolution("1920x800")
+	if r == nil || r.Label != "1080p" {
+		t.Errorf("expected \"1080p\", got %+v", r)
+	}
+	r = parseResolution("4K")
+	if r == nil || r.Height != 2160 || r.Label != "2160p" {
+		t.Errorf("expected \"2160p\", got %+v", r)
+	}
+	r = parseResolution("848x480")
+	if r == nil || r.Label != "480p" {
+		t.Errorf("expected \"480p\", got %+v", r)
+	}
+}
+
+func TestResolutionCompare(t *testing.T) {
+	r1080p := parseResolution("1080p")
+	r1080i := parseResolution("1080i")
+	r720p := parseResolution("1280x720")
+	if !r1080p.IsBetterThan(r720p) {
+		t.Error("expected true, got false")
+	}
+	if r720p.IsBetterThan(r1080p) {
+		t.Error("expected false, got true")
+	}
+	if !r1080p.IsBetterThan(r1080i) {
+		t.Error("expected true, got false")
+	}
+	if c := r1080p.Compare(parseResolution("1080")); c != 0 {
+		t.Errorf("expected 0, got %d", c)
+	}
+	if c := r1080p.Compare(parseResolution("1920x1080")); c != 0 {
+		t.Errorf("expected 0, got %d", c)
+	}
+	if c := parseResolution("1920x1080").Compare(r1080p); c != 0 {
+		t.Errorf("expected 0, got %d", c)
+	}
+	if !parseResolution("1920x1080").IsBetterThan(parseResolution("1440x1080")) {
+		t.Error("expected true, got false")
+	}
+	if c := r720p.Compare(nil); c != 1 {
+		t.Errorf("expected 1, got %d", c)
+	}
+	var rNil *Resolution
+	if c := rNil.Compare(r720p); c != -1 {
+		t.Errorf("expected -1, got %d", c)
+	}
+}
+
+func TestResolutionParse(t *testing.T) {
+	e := Parse("[Group] Title - 01 [BD 1920x1080 HEVC].mkv", DefaultOptions)
+	if e.Resolution == nil || e.Resolution.Label != "1080p" {
+		t.Errorf("expected \"1080p\", got %+v", e.Resolution)
+	}
+	e = Parse("[Group] Title - 01 (WEB 4K HEVC).mkv", DefaultOptions)
+	if e.Resolution == nil || e.Resolution.Label != "2160p" {
+		t.Errorf("expected \"2160p\", got %+v", e.Resolution)
+	}
+	e = Parse("[Group] Title - 01 [2160p].mkv", DefaultOptions)
+	if e.VideoResolution != "2160p" || e.Resolution == nil || e.Resolution.Label != "2160p" {
+		t.Errorf("expected \"2160p\", got %+v", e.Resolution)
+	}
+	e = Parse("[Group] Title - 01 [1080].mkv", DefaultOptions)
+	if e.VideoResolution != "1080" || e.Resolution == nil || e.Resolution.Label != "1080p" {
+		t.Errorf("expected \"1080p\", got %+v", e.Resolution)
+	}
+	e = Parse("[Group] One Piece - 576.mkv", DefaultOptions)
+	if e.Resolution != nil {
+		t.Errorf("expected nil, got %+v", e.Resolution)
+	}
+	if !equal(e.EpisodeNumber, []string{"576"}) {
+		t.Errorf("expected [576], got %v", e.EpisodeNumber)
+	}
+	e = Parse("[Group] One Piece (576).mkv", DefaultOptions)
+	if e.Resolution != nil {
+		t.Errorf("expected nil, got %+v", e.Resolution)
+	}
+}
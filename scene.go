@@ -0,0 +1,142 @@
+package tanuki
+
+import (
+	"regexp"
+	"strings"
+)
+
+// SceneNaming determines how scene-style release names are handled,
+// e.g "Frieren.Beyond.Journeys.End.S01E05.1080p.CR.WEB-DL.AAC2.0.H.264-VARYG".
+type SceneNaming int
+
+const (
+	// Scene-style names are detected automatically: dot-separated names without spaces, underscores or brackets.
+	SceneNamingAuto SceneNaming = iota
+
+	// Filenames are always parsed as scene-style names.
+	SceneNamingAlways
+
+	// Filenames are never parsed as scene-style names.
+	SceneNamingNever
+)
+
+// Minimum number of dots for a filename to be detected as a scene-style name
+const sceneNameMinDots = 3
+
+// e.g "S01E05", "S01", "S01E05E06", "1x05"
+var sceneSeasonEpisodeRe = regexp.MustCompile(`(?i)^(?:S\d{1,2}(?:E\d{1,4})*|\d{1,2}x\d{2,4})$`)
+
+// e.g "VARYG" in "H.264-VARYG"
+var sceneReleaseGroupRe = regexp.MustCompile(`-([A-Za-z0-9]+)$`)
+
+// isSceneName returns true if the filename should be parsed as a scene-style name.
+func isSceneName(filename string, mode SceneNaming) bool {
+	switch mode {
+	case SceneNamingAlways:
+		return true
+	case SceneNamingNever:
+		return false
+	}
+	if strings.ContainsAny(filename, " _()[]{}「」『』【】（）") {
+		return false
+	}
+	return strings.Count(filename, ".") >= sceneNameMinDots
+}
+
+// splitSceneReleaseGroup removes the trailing "-GROUP" suffix of a scene-style name and returns it.
+// Suffixes that belong to a keyword are kept, e.g "WEB-DL", "x264-10bit".
+func splitSceneReleaseGroup(km *keywordManager, filename string) (string, string) {
+	lastSegment := filename[strings.LastIndex(filename, ".")+1:]
+	match := sceneReleaseGroupRe.FindStringSubmatch(lastSegment)
+	if match == nil || len(match[0]) == len(lastSegment) {
+		return filename, ""
+	}
+	if _, found := km.findWithoutCategory(km.normalize(lastSegment)); found {
+		return filename, ""
+	}
+	if _, found := km.findWithoutCategory(km.normalize(match[1])); found {
+		return filename, ""
+	}
+	return strings.TrimSuffix(filename, match[0]), match[1]
+}
+
+// tokenizeSceneName splits a scene-style name on dots, keeping dotted keywords together, e.g "AAC2.0", "H.264", "5.1".
+func (t *tokenizer) tokenizeSceneName(filename string) {
+	segments := strings.Split(filename, ".")
+	for i := 0; i < len(segments); i++ {
+		if i > 0 {
+			t.addToken(tokenCategoryDelimiter, ".", false)
+		}
+		segment := segments[i]
+		if i+1 < len(segments) {
+			if _, found := t.keywordManager.findWithoutCategory(t.keywordManager.normalize(segment + "." + segments[i+1])); found {
+				segment += "." + segments[i+1]
+				i++
+			}
+		}
+		if segment == "" {
+			continue
+		}
+		// Resolutions are pre-identified so that they are not mistaken for numbers, e.g "Episode.1080p"
+		if isResolution(segment) && !t.elements.contains(elementCategoryVideoResolution) {
+			t.elements.insert(elementCategoryVideoResolution, segment)
+			t.addToken(tokenCategoryIdentifier, segment, false)
+			continue
+		}
+		// Compound keywords are split like in other names, e.g "x264-10bit"
+		if preIdentified := t.keywordManager.scan(segment, t.options.AllowedDelimiters, t.elements); len(preIdentified) > 0 {
+			t.tokenizeSceneSegment(segment, preIdentified)
+			continue
+		}
+		t.addToken(tokenCategoryUnknown, segment, false)
+	}
+}
+
+// tokenizeSceneSegment splits a segment of a scene-style name on the keywords found by scan, e.g "x264-10bit".
+// Unlike tokenizeByPreidentified, the text between the keywords is not split on delimiters, as the segments have none.
+func (t *tokenizer) tokenizeSceneSegment(segment string, preIdentified indexSets) {
+	lastTokenEndPos := 0
+	for _, m := range preIdentified {
+		if m.beginPos > lastTokenEndPos {
+			t.addToken(tokenCategoryUnknown, segment[lastTokenEndPos:m.beginPos], false)
+		}
+		if m.category != elementCategoryUnknown {
+			t.addToken(tokenCategoryIdentifier, segment[m.beginPos:m.endPos], false)
+		} else {
+			t.addToken(tokenCategoryUnknown, segment[m.beginPos:m.endPos], false)
+		}
+		lastTokenEndPos = m.endPos
+	}
+	if lastTokenEndPos < len(segment) {
+		t.addToken(tokenCategoryUnknown, segment[lastTokenEndPos:], false)
+	}
+}
+
+// Build the anime title of a scene-style name, which ends at the first season and episode number or year,
+// e.g "Frieren.Beyond.Journeys.End.2023.S01E05" is "Frieren Beyond Journeys End".
+func (p *parser) searchForSceneTitle() {
+	tokenBegin, found := p.tokenizer.tokens.find(tokenFlagsUnknown)
+	if !found {
+		return
+	}
+	tkn := tokenBegin
+	for {
+		tkn, found = p.tokenizer.tokens.findNext(*tkn, tokenFlagsNotDelimiter)
+		if !found || tkn.Category != tokenCategoryUnknown {
+			return
+		}
+		if sceneSeasonEpisodeRe.MatchString(tkn.Content) {
+			break
+		}
+		if isNumeric(tkn.Content) {
+			n := stringToInt(tkn.Content)
+			if len(tkn.Content) == 4 && p.tokenizer.options.Limits.isValidYear(n) {
+				p.tokenizer.elements.insert(elementCategoryAnimeYear, tkn.Content)
+				tkn.Category = tokenCategoryIdentifier
+				break
+			}
+		}
+	}
+	tokenEnd, _ := p.tokenizer.tokens.findPrevious(*tkn, tokenFlagsNotDelimiter)
+	p.buildElement(elementCategoryAnimeTitle, tokenBegin, tokenEnd, false)
+}
@@ -0,0 +1,102 @@
+package tanuki
+
+import (
+	"testing"
+)
+
+func TestSceneIsSceneName(t *testing.T) {
+	ret := isSceneName("[Group] Title - 01 [1080p]", SceneNamingAuto)
+	if ret {
+		t.Error("expected false, got true")
+	}
+	ret = isSceneName("Title.01", SceneNamingAuto)
+	if ret {
+		t.Error("expected false, got true")
+	}
+	ret = isSceneName("Title.S01E05.1080p.WEB-DL-GROUP", SceneNamingAuto)
+	if !ret {
+		t.Error("expected true, got false")
+	}
+	ret = isSceneName("Title.S01E05.1080p.WEB-DL-GROUP", SceneNamingNever)
+	if ret {
+		t.Error("expected false, got true")
+	}
+	ret = isSceneName("Title S01E05", SceneNamingAlways)
+	if !ret {
+		t.Error("expected true, got false")
+	}
+}
+
+func TestSceneSplitSceneReleaseGroup(t *testing.T) {
+	km := newKeywordManager()
+	testCases := []struct {
+		filename     string
+		expected     string
+		releaseGroup string
+	}{
+		{"Title.S01E05.1080p.H.264-VARYG", "Title.S01E05.1080p.H.264", "VARYG"},
+		{"Title.S01E05.1080p.x265-NeoNoir", "Title.S01E05.1080p.x265", "NeoNoir"},
+		{"Title.S01E05.1080p.WEB-DL", "Title.S01E05.1080p.WEB-DL", ""},
+		{"Title.S01E05.1080p.x264-10bit", "Title.S01E05.1080p.x264-10bit", ""},
+		{"Title.S01E05.1080p.-GROUP", "Title.S01E05.1080p.-GROUP", ""},
+	}
+	for _, tc := range testCases {
+		filename, releaseGroup := splitSceneReleaseGroup(km, tc.filename)
+		if filename != tc.expected {
+			t.Errorf("expected \"%s\", got \"%s\"", tc.expected, filename)
+		}
+		if releaseGroup != tc.releaseGroup {
+			t.Errorf("expected \"%s\", got \"%s\"", tc.releaseGroup, releaseGroup)
+		}
+	}
+}
+
+func TestSceneTokenizeSceneName(t *testing.T) {
+	tkz := tokenizer{
+		tokens:         &tokens{},
+		keywordManager: newKeywordManager(),
+		elements:       &Elements{},
+	}
+	tkz.tokenizeSceneName("Title.1080p.AAC2.0.H.264")
+	expected := []string{"Title", ".", "1080p", ".", "AAC2.0", ".", "H.264"}
+	if len(*tkz.tokens) != len(expected) {
+		t.Fatalf("expected %d tokens, got %d", len(expected), len(*tkz.tokens))
+	}
+	for i, v := range *tkz.tokens {
+		if v.Content != expected[i] {
+			t.Errorf("expected \"%s\", got \"%s\"", expected[i], v.Content)
+		}
+	}
+	if tkz.elements.VideoResolution != "1080p" {
+		t.Errorf("expected \"1080p\", got \"%s\"", tkz.elements.VideoResolution)
+	}
+}
+
+func TestSceneParse(t *testing.T) {
+	e := Parse("Frieren.Beyond.Journeys.End.2023.S01E05.Title.Of.Episode.1080p.NF.WEB-DL.DDP5.1.H.264-VARYG.mkv", DefaultOptions)
+	if e.AnimeTitle != "Frieren Beyond Journeys End" {
+		t.Errorf("expected \"Frieren Beyond Journeys End\", got \"%s\"", e.AnimeTitle)
+	}
+	if e.AnimeYear != "2023" {
+		t.Errorf("expected \"2023\", got \"%s\"", e.AnimeYear)
+	}
+	if e.EpisodeTitle != "Title Of Episode" {
+		t.Errorf("expected \"Title Of Episode\", got \"%s\"", e.EpisodeTitle)
+	}
+	if e.ReleaseGroup != "VARYG" {
+		t.Errorf("expected \"VARYG\", got \"%s\"", e.ReleaseGroup)
+	}
+	if len(e.AudioTerm) != 1 || e.AudioTerm[0] != "DDP5.1" {
+		t.Errorf("expected [DDP5.1], got %v", e.AudioTerm)
+	}
+	e = Parse("Spy.x.Family.S01E05.1080p.BluRay.x264-10bit-GRP.mkv", DefaultOptions)
+	if e.EpisodeTitle != "" {
+		t.Errorf("expected \"\", got \"%s\"", e.EpisodeTitle)
+	}
+	if e.VideoBitDepth != 10 {
+		t.Errorf("expected 10, got %d", e.VideoBitDepth)
+	}
+	if len(e.Unknown) != 0 {
+		t.Errorf("expected [], got %v", e.Unknown)
+	}
+}
@@ -0,0 +1,68 @@
+package tanuki
+
+import (
+	"regexp"
+	"strings"
+)
+
+// e.g "1", "02", "12.5", "02v2"
+var specialNumberRe = regexp.MustCompile(`(?i)^\d{1,4}(?:\.\d)?(?:v\d)?$`)
+
+func isSpecialKind(kind MediaKind) bool {
+	return kind == MediaKindSpecial || kind == MediaKindOVA
+}
+
+// Returns the kind of special of a keyword, e.g MediaKindOVA for "OAD"
+func (p *parser) specialKind(w string) (MediaKind, bool) {
+	kind, found := mediaKinds[p.tokenizer.keywordManager.normalize(w)]
+	if !found || !isSpecialKind(kind) {
+		return "", false
+	}
+	return kind, true
+}
+
+// Identify the special keyword along with the number following it, e.g "SP 02", "Special 03", "OVA - 2".
+// The number is parsed as the episode number, and the keyword ends the title.
+func (p *parser) checkSpecialKeyword(tkn *token) bool {
+	kind, found := p.specialKind(tkn.Content)
+	if !found {
+		return false
+	}
+	nextToken, found := p.tokenizer.tokens.findNext(*tkn, tokenFlagsNotDelimiter)
+	if found && isDashCharacter(nextToken.Content) { // e.g "OVA - 02"
+		nextToken, found = p.tokenizer.tokens.findNext(*nextToken, tokenFlagsNotDelimiter)
+	}
+	if !found || nextToken.Category != tokenCategoryUnknown || !specialNumberRe.MatchString(nextToken.Content) {
+		return false
+	}
+	if !p.matchEpisodePattern(nextToken.Content, nextToken) && !p.setEpisodeNumber(nextToken.Content, nextToken, true) {
+		return false
+	}
+	p.tokenizer.elements.insert(elementCategoryAnimeType, tkn.Content)
+	p.tokenizer.elements.SpecialType = kind
+	tkn.Category = tokenCategoryIdentifier
+	return true
+}
+
+// Flag the special episodes: the numbered specials, season 0 and fractional episodes,
+// e.g "SP1", "S00E04", "12.5".
+func (p *parser) resolveSpecial() {
+	elems := p.tokenizer.elements
+	if elems.SpecialType == "" {
+		for _, season := range elems.AnimeSeason {
+			if isNumeric(season) && stringToInt(season) == 0 {
+				elems.SpecialType = MediaKindSpecial
+			}
+		}
+	}
+	if elems.SpecialType == "" && len(elems.EpisodeNumber) > 0 && strings.Contains(elems.EpisodeNumber[0], ".") {
+		elems.SpecialType = MediaKindSpecial
+	}
+	if elems.SpecialType == "" {
+		return
+	}
+	elems.IsSpecial = true
+	if len(elems.EpisodeNumber) > 0 {
+		elems.SpecialNumber = elems.EpisodeNumber[0]
+	}
+}
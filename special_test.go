@@ -0,0 +1,42 @@
+package tanuki
+
+import "testing"
+
+func TestSpecialCheckSpecialKeyword(t *testing.T) {
+	e := Parse("[Group] Title - SP 02 [1080p].mkv", DefaultOptions)
+	if !e.IsSpecial {
+		t.Error("expected true, got false")
+	}
+	if e.SpecialNumber != "02" {
+		t.Errorf("expected \"02\", got \"%s\"", e.SpecialNumber)
+	}
+	if e.AnimeTitle != "Title" {
+		t.Errorf("expected \"Title\", got \"%s\"", e.AnimeTitle)
+	}
+	e = Parse("[Group] Title - OVA 2 [1080p].mkv", DefaultOptions)
+	if e.SpecialType != MediaKindOVA {
+		t.Errorf("expected \"%s\", got \"%s\"", MediaKindOVA, e.SpecialType)
+	}
+	e = Parse("Yumeiro Patissiere SP Professional 01.mkv", DefaultOptions)
+	if e.IsSpecial {
+		t.Error("expected false, got true")
+	}
+	if e.AnimeTitle != "Yumeiro Patissiere SP Professional" {
+		t.Errorf("expected \"Yumeiro Patissiere SP Professional\", got \"%s\"", e.AnimeTitle)
+	}
+}
+
+func TestSpecialResolveSpecial(t *testing.T) {
+	e := Parse("Title S00E04.mkv", DefaultOptions)
+	if !e.IsSpecial || e.SpecialNumber != "04" {
+		t.Errorf("expected special 04, got %v \"%s\"", e.IsSpecial, e.SpecialNumber)
+	}
+	e = Parse("[Group] Title - 12.5 [1080p].mkv", DefaultOptions)
+	if !e.IsSpecial || e.SpecialNumber != "12.5" {
+		t.Errorf("expected special 12.5, got %v \"%s\"", e.IsSpecial, e.SpecialNumber)
+	}
+	e = Parse("[Group] Title - 12 [1080p].mkv", DefaultOptions)
+	if e.IsSpecial {
+		t.Error("expected false, got true")
+	}
+}
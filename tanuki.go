@@ -11,12 +11,16 @@ const maxExtensionLength = 4
 //
 // Custom options can be specified by creating a new Options struct and passing it to the Parse function.
 var DefaultOptions = Options{
-	AllowedDelimiters:  " _.&+,|",
-	IgnoredStrings:     []string{},
-	ParseEpisodeNumber: true,
-	ParseEpisodeTitle:  true,
-	ParseFileExtension: true,
-	ParseReleaseGroup:  true,
+	AllowedDelimiters:   " _.&+,|",
+	IgnoredStrings:      []string{},
+	ParseEpisodeNumber:  true,
+	ParseEpisodeTitle:   true,
+	ParseFileExtension:  true,
+	ParseReleaseGroup:   true,
+	PreferNativeTitle:   false,
+	ReleaseGroupAliases: nil,
+	SceneNaming:         SceneNamingAuto,
+	Limits:              DefaultLimits,
 }
 
 // Parse returns a pointer to an Elements struct created by parsing a filename with the specified options.
@@ -40,16 +44,28 @@ func Parse(filename string, options Options) *Elements {
 		elems.insert(elementCategoryFileExtension, extension)
 	}
 
+	options.Limits = options.Limits.withDefaults()
+
 	if options.IgnoredStrings != nil {
 		filename = removeIgnoredStrings(filename, options.IgnoredStrings)
 	}
 
+	sceneNaming := isSceneName(filename, options.SceneNaming)
+	if sceneNaming {
+		var releaseGroup string
+		filename, releaseGroup = splitSceneReleaseGroup(km, filename)
+		if releaseGroup != "" && options.ParseReleaseGroup {
+			elems.insert(elementCategoryReleaseGroup, releaseGroup)
+		}
+	}
+
 	tkz := tokenizer{
 		filename:       filename,
 		options:        options,
 		tokens:         tkns,
 		keywordManager: km,
 		elements:       elems,
+		sceneNaming:    sceneNaming,
 	}
 	tkz.tokenize()
 
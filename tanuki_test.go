@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"io"
 	"os"
+	"reflect"
 	"testing"
 )
 
@@ -13,7 +14,7 @@ type failedParse struct {
 }
 
 func TestTanukiParse(t *testing.T) {
-	os.Setenv("TANUKI_DATA_PATH", "./test/isolated.json")
+	os.Setenv("TANUKI_DATA_PATH", "./test/data.json")
 	testDataPath := os.Getenv("TANUKI_DATA_PATH")
 	if testDataPath == "" {
 		t.Fatal("Missing TANUKI_DATA_PATH environment variable for json test data file")
@@ -179,6 +180,11 @@ func TestTanukiParse(t *testing.T) {
 				Expected: v,
 				Got:      *ret,
 			})
+		} else if !equalSetFields(v, *ret) {
+			notMatched = append(notMatched, failedParse{
+				Expected: v,
+				Got:      *ret,
+			})
 		}
 	}
 
@@ -233,6 +239,23 @@ func BenchmarkTanukiParse(b *testing.B) {
 	}
 }
 
+// Returns true if the fields set in the expected elements match, e.g "media_kind" and "release_groups",
+// which are only set for the test data covering them.
+func equalSetFields(expected, got Elements) bool {
+	expectedFields := map[string]interface{}{}
+	gotFields := map[string]interface{}{}
+	expectedJSON, _ := json.Marshal(expected)
+	gotJSON, _ := json.Marshal(got)
+	json.Unmarshal(expectedJSON, &expectedFields)
+	json.Unmarshal(gotJSON, &gotFields)
+	for field, value := range expectedFields {
+		if !reflect.DeepEqual(value, gotFields[field]) {
+			return false
+		}
+	}
+	return true
+}
+
 func equal(a, b []string) bool {
 	if len(a) != len(b) {
 		return false
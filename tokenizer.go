@@ -5,6 +5,7 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+	"unicode/utf8"
 )
 
 // Options is a struct that allows you to change the parsing behavior.
@@ -38,6 +39,28 @@ type Options struct {
 	// DefaultOptions value: true
 	// Determines if the release group will be parsed into the Elements struct.
 	ParseReleaseGroup bool
+
+	// DefaultOptions value: false
+	// Determines if the title in its native script is preferred as the AnimeTitle when the filename has several titles,
+	// e.g "葬送のフリーレン" rather than "Sousou no Frieren". Other titles are parsed into AnimeTitleAlternatives.
+	PreferNativeTitle bool
+
+	// DefaultOptions value: nil
+	// Aliases used to resolve the canonical names of the release groups in Elements.ReleaseGroups,
+	// e.g "ERAI-RAWS" and "Erai-raws" are both "Erai-raws". They take precedence over the built-in aliases.
+	// Aliases can be built with NewReleaseGroupAliases, or loaded from a JSON file with LoadReleaseGroupAliases.
+	ReleaseGroupAliases ReleaseGroupAliases
+
+	// DefaultOptions value: SceneNamingAuto
+	// Determines if the filename is parsed as a scene-style name, e.g "Title.S01E05.1080p.WEB-DL.AAC2.0.H.264-GROUP".
+	// In this mode, dotted keywords like "AAC2.0" are kept together, the trailing "-GROUP" is the release group
+	// and the anime title ends at the first season and episode number or year.
+	SceneNaming SceneNaming
+
+	// DefaultOptions value: DefaultLimits
+	// Numeric bounds used to validate the years, episode numbers and volume numbers found in the filename,
+	// e.g long runners with more than 1899 episodes need a higher Limits.EpisodeNumberMax.
+	Limits Limits
 }
 
 type tokenizer struct {
@@ -46,6 +69,7 @@ type tokenizer struct {
 	tokens         *tokens
 	keywordManager *keywordManager
 	elements       *Elements
+	sceneNaming    bool
 }
 
 func (t *tokenizer) addToken(cat int, content string, enclosed bool) {
@@ -57,6 +81,11 @@ func (t *tokenizer) addToken(cat int, content string, enclosed bool) {
 }
 
 func (t *tokenizer) tokenize() {
+	if t.sceneNaming {
+		t.tokenizeSceneName(t.filename)
+		return
+	}
+
 	brackets := [][]rune{
 		{'(', ')'},
 		{'[', ']'},
@@ -90,9 +119,11 @@ func (t *tokenizer) tokenize() {
 
 		// Found bracket
 		if bracketIndex != -1 {
-			t.addToken(tokenCategoryBracket, string(text[bracketIndex]), true)
+			// Brackets can be multi-byte, e.g "【"
+			_, bracketSize := utf8.DecodeRuneInString(text[bracketIndex:])
+			t.addToken(tokenCategoryBracket, text[bracketIndex:bracketIndex+bracketSize], true)
 			isBracketOpen = !isBracketOpen
-			text = text[bracketIndex+1:]
+			text = text[bracketIndex+bracketSize:]
 		} else { // Reached the end
 			text = ""
 		}
@@ -100,7 +131,7 @@ func (t *tokenizer) tokenize() {
 }
 
 func (t *tokenizer) tokenizeByPreidentified(filename string, enclosed bool) {
-	preIdentifiedtokens := t.keywordManager.peek(filename, t.elements)
+	preIdentifiedtokens := t.keywordManager.scan(filename, t.options.AllowedDelimiters, t.elements)
 
 	lastTokenEndPos := 0
 	for _, preIdentified := range preIdentifiedtokens {
@@ -111,7 +142,11 @@ func (t *tokenizer) tokenizeByPreidentified(filename string, enclosed bool) {
 			t.tokenizeByDelimiters(filename[lastTokenEndPos:tknBeginPos], enclosed)
 		}
 		if tknEndPos <= len(filename) {
-			t.addToken(tokenCategoryIdentifier, filename[tknBeginPos:tknEndPos], enclosed)
+			if preIdentified.category != elementCategoryUnknown {
+				t.addToken(tokenCategoryIdentifier, filename[tknBeginPos:tknEndPos], enclosed)
+			} else {
+				t.addToken(tokenCategoryUnknown, filename[tknBeginPos:tknEndPos], enclosed)
+			}
 			lastTokenEndPos = tknEndPos
 		}
 	}
@@ -86,6 +86,19 @@ func TestTokenizerTokenize(t *testing.T) {
 	}
 }
 
+func TestTokenizerTokenizeMultiByteBrackets(t *testing.T) {
+	psr := getTestParser("【MMZYSUB】【Golden Time】")
+	expected := []string{"【", "MMZYSUB", "】", "【", "Golden", " ", "Time", "】"}
+	if len(*psr.tokenizer.tokens) != len(expected) {
+		t.Fatalf("expected %d tokens, got %d", len(expected), len(*psr.tokenizer.tokens))
+	}
+	for i, v := range *psr.tokenizer.tokens {
+		if v.Content != expected[i] {
+			t.Errorf("expected \"%s\", got \"%s\"", expected[i], v.Content)
+		}
+	}
+}
+
 func TestTokenizerSplitWith(t *testing.T) {
 	re := regexp.MustCompile(" ")
 	ret := splitWith(re, "", 0)
@@ -0,0 +1,61 @@
+package tanuki
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// Returns true if the token was left out of every element. Unidentifiable keywords keep their category
+// once inserted, e.g "HEVC" in "[1080p HEVC]", but keywords that inserted nothing are left out, e.g "[Season]".
+// Tokens consumed while resolving the elements are not left out, e.g "[Multi Subs]".
+func (p *parser) isLeftOut(tkn *token) bool {
+	if tkn.Category != tokenCategoryUnknown || p.resolvedTokens[tkn] {
+		return false
+	}
+	km := p.tokenizer.keywordManager
+	kd, found := km.findWithoutCategory(km.normalize(tkn.Content))
+	if !found {
+		return true
+	}
+	return !checkInList(p.tokenizer.elements.get(kd.category), strings.Trim(tkn.Content, " -"))
+}
+
+// Report the tokens left out of every element in Unknown, one entry per bracket,
+// e.g "[Group] Title - 05 [Foo Bar] [1080p]" has the "Foo Bar" entry.
+// The coverage is the share of characters of the tokens, brackets and delimiters aside, assigned to some element.
+func (p *parser) resolveUnknown() {
+	var words []string
+	flush := func() {
+		if len(words) > 0 {
+			p.tokenizer.elements.insert(elementCategoryUnknown, strings.Join(words, " "))
+			words = nil
+		}
+	}
+
+	total, assigned := 0, 0
+	for _, tkn := range *p.tokenizer.tokens {
+		switch tkn.Category {
+		case tokenCategoryBracket:
+			flush()
+			continue
+		case tokenCategoryDelimiter, tokenCategoryInvalid:
+			continue
+		}
+		// Lone dashes and separators only delimit, e.g "-" in "Title - 05"
+		if content := strings.TrimSpace(tkn.Content); content == "" || isDashCharacter(content) || isSeparatorCharacter(content) {
+			continue
+		}
+		length := utf8.RuneCountInString(tkn.Content)
+		total += length
+		if p.isLeftOut(tkn) {
+			words = append(words, tkn.Content)
+		} else {
+			assigned += length
+		}
+	}
+	flush()
+
+	if total > 0 {
+		p.tokenizer.elements.Coverage = float64(assigned) / float64(total)
+	}
+}
@@ -0,0 +1,63 @@
+package tanuki
+
+import "testing"
+
+func TestUnknownResolveUnknown(t *testing.T) {
+	e := Parse("[Group] Title - 05 [Foo Bar] [1080p HEVC].mkv", DefaultOptions)
+	if len(e.Unknown) != 1 || e.Unknown[0] != "Foo Bar" {
+		t.Errorf("expected [Foo Bar], got %v", e.Unknown)
+	}
+	if e.Coverage <= 0 || e.Coverage >= 1 {
+		t.Errorf("expected a coverage between 0 and 1, got %f", e.Coverage)
+	}
+	e = Parse("[Group] Title - 05 (BD 1080p) [ABCD1234].mkv", DefaultOptions)
+	if len(e.Unknown) != 0 {
+		t.Errorf("expected [], got %v", e.Unknown)
+	}
+	if e.Coverage != 1 {
+		t.Errorf("expected 1, got %f", e.Coverage)
+	}
+	e = Parse("[Group] Title - 05 [Foo] [Bar].mkv", DefaultOptions)
+	if len(e.Unknown) != 2 || e.Unknown[0] != "Foo" || e.Unknown[1] != "Bar" {
+		t.Errorf("expected [Foo Bar], got %v", e.Unknown)
+	}
+	e = Parse("[Group] Title - 05 [Season] [1080p].mkv", DefaultOptions)
+	if len(e.Unknown) != 1 || e.Unknown[0] != "Season" {
+		t.Errorf("expected [Season], got %v", e.Unknown)
+	}
+	if e.Coverage >= 1 {
+		t.Errorf("expected a coverage below 1, got %f", e.Coverage)
+	}
+	e = Parse("[Group] Title - 05 [Part] [1080p].mkv", DefaultOptions)
+	if len(e.Unknown) != 1 || e.Unknown[0] != "Part" {
+		t.Errorf("expected [Part], got %v", e.Unknown)
+	}
+	e = Parse("[Group] Title - 05 (Cour) [1080p].mkv", DefaultOptions)
+	if len(e.Unknown) != 1 || e.Unknown[0] != "Cour" {
+		t.Errorf("expected [Cour], got %v", e.Unknown)
+	}
+	if e.Coverage >= 1 {
+		t.Errorf("expected a coverage below 1, got %f", e.Coverage)
+	}
+	e = Parse("[Group] Title - 05 [Multi Subs].mkv", DefaultOptions)
+	if len(e.Unknown) != 0 {
+		t.Errorf("expected [], got %v", e.Unknown)
+	}
+	if e.Coverage != 1 {
+		t.Errorf("expected 1, got %f", e.Coverage)
+	}
+	e = Parse("[Group] Title - 05 [Multiple Subtitle].mkv", DefaultOptions)
+	if len(e.Unknown) != 0 {
+		t.Errorf("expected [], got %v", e.Unknown)
+	}
+	if e.Coverage != 1 {
+		t.Errorf("expected 1, got %f", e.Coverage)
+	}
+	e = Parse("[Group] Title - 05 [1080pHEVC][BD1080p].mkv", DefaultOptions)
+	if len(e.Unknown) != 0 {
+		t.Errorf("expected [], got %v", e.Unknown)
+	}
+	if e.Coverage != 1 {
+		t.Errorf("expected 1, got %f", e.Coverage)
+	}
+}